@@ -6,15 +6,22 @@ import (
 	"bytes"
 
 	"fmt"
+	"io"
+	"math/big"
 	"net/http"
 	"strings"
+	"time"
 
 	"github.com/dailycrypto-me/daily-indexer/internal/chain"
 	"github.com/dailycrypto-me/daily-indexer/internal/common"
+	"github.com/dailycrypto-me/daily-indexer/internal/contracts"
+	"github.com/dailycrypto-me/daily-indexer/internal/events"
 	"github.com/dailycrypto-me/daily-indexer/internal/storage"
 	"github.com/dailycrypto-me/daily-indexer/internal/storage/pebble"
 	"github.com/dailycrypto-me/daily-indexer/internal/transaction"
 	. "github.com/dailycrypto-me/daily-indexer/models"
+	ethcommon "github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
 	"github.com/ethereum/go-ethereum/rlp"
 	"github.com/labstack/echo/v4"
 	"github.com/nleeper/goment"
@@ -36,6 +43,9 @@ func GetAddressDataPage[T storage.Paginated](a *ApiHandler, address AddressFilte
 	log.WithFields(logFields).Debug("GetAddressDataPage")
 
 	ret, pagination := storage.GetObjectsPage[T](a.storage, address, getPaginationStart(pag.Start), pag.Limit)
+	if trxs, ok := any(ret).([]storage.Transaction); ok {
+		decodeStoredMethods(trxs)
+	}
 
 	response := struct {
 		PaginatedResponse
@@ -48,6 +58,13 @@ func GetAddressDataPage[T storage.Paginated](a *ApiHandler, address AddressFilte
 	return response
 }
 
+// decodeStoredMethods applies ABIs and signatures added after the transactions were indexed
+func decodeStoredMethods(trxs []storage.Transaction) {
+	for i := range trxs {
+		transaction.DecodeStoredMethod(&trxs[i])
+	}
+}
+
 func GetHoldersDataPage(a *ApiHandler, pag *PaginationParam) interface{} {
 	ret, pagination := storage.GetHoldersPage(a.storage, getPaginationStart(pag.Start), pag.Limit)
 
@@ -62,14 +79,93 @@ func GetHoldersDataPage(a *ApiHandler, pag *PaginationParam) interface{} {
 	return response
 }
 
-func (a *ApiHandler) GetChainStats(ctx echo.Context) error {
-	stats := a.stats.GetStats()
+func getChainStatsWindow[T ~string](window *T, defaultBlocks uint64) storage.ChainStatsWindow {
+	if window == nil {
+		return storage.ChainStatsWindow{Blocks: defaultBlocks}
+	}
+	return storage.ChainStatsWindows[string(*window)]
+}
+
+// GetChainStats returns chain stats for the last blocks. Saved samples are used if window is specified or in-memory stats aren't collected yet after restart
+func (a *ApiHandler) GetChainStats(ctx echo.Context, params GetChainStatsParams) error {
+	var stats *ChainStats
+	if params.Window == nil {
+		stats = a.stats.GetStats()
+	}
+	if stats == nil {
+		window := getChainStatsWindow(params.Window, uint64(a.config.ChainStatsInterval))
+		stats = storage.GetChainStats(a.storage, window, a.storage.GetFinalizationData().PbftCount)
+	}
 	if stats == nil {
 		return ctx.JSON(http.StatusNotFound, "Chain stats not found")
 	}
 	return ctx.JSON(http.StatusOK, *stats)
 }
 
+// GetChainStatsHistory returns chain stats for the window calculated at evenly distributed blocks of the range
+func (a *ApiHandler) GetChainStatsHistory(ctx echo.Context, params GetChainStatsHistoryParams) error {
+	window := getChainStatsWindow(params.Window, uint64(a.config.ChainStatsInterval))
+	from, to := uint64(0), a.storage.GetFinalizationData().PbftCount
+	if params.FromBlock != nil {
+		from = *params.FromBlock
+	}
+	if params.ToBlock != nil && *params.ToBlock < to {
+		to = *params.ToBlock
+	}
+	points := uint64(100)
+	if params.Points != nil {
+		points = *params.Points
+	}
+	return ctx.JSON(http.StatusOK, ChainStatsHistoryResponse{Data: storage.GetChainStatsHistory(a.storage, window, from, to, points)})
+}
+
+func getGasBlocks(blocks *GasBlocksParam) uint64 {
+	if blocks == nil {
+		return 200
+	}
+	return *blocks
+}
+
+// GetGasStats returns gas price percentiles and suggested gas prices for the last blocks
+func (a *ApiHandler) GetGasStats(ctx echo.Context, params GetGasStatsParams) error {
+	stats := storage.GetGasStats(a.storage, getGasBlocks(params.Blocks), a.storage.GetFinalizationData().PbftCount)
+	if stats == nil {
+		return ctx.JSON(http.StatusNotFound, "Gas price stats not found")
+	}
+	return ctx.JSON(http.StatusOK, *stats)
+}
+
+// GetGasStatsHistory returns gas price stats for the window calculated at evenly distributed blocks of the range
+func (a *ApiHandler) GetGasStatsHistory(ctx echo.Context, params GetGasStatsHistoryParams) error {
+	from, to := uint64(0), a.storage.GetFinalizationData().PbftCount
+	if params.FromBlock != nil {
+		from = *params.FromBlock
+	}
+	if params.ToBlock != nil && *params.ToBlock < to {
+		to = *params.ToBlock
+	}
+	points := uint64(100)
+	if params.Points != nil {
+		points = *params.Points
+	}
+	return ctx.JSON(http.StatusOK, GasStatsHistoryResponse{Data: storage.GetGasStatsHistory(a.storage, getGasBlocks(params.Blocks), from, to, points)})
+}
+
+// GetDecentralizationStats returns stake, PBFT and DAG blocks distribution metrics of the week
+func (a *ApiHandler) GetDecentralizationStats(ctx echo.Context, params GetDecentralizationStatsParams) error {
+	year, week := getYearWeek(params.Week)
+	currentYear, currentWeek := getYearWeek(nil)
+	top := uint64(10)
+	if params.Top != nil {
+		top = *params.Top
+	}
+	stats := storage.GetDecentralizationStats(a.storage, year, week, year == currentYear && week == currentWeek, int(top))
+	if stats == nil {
+		return ctx.JSON(http.StatusNotFound, "Validator set of the week not found")
+	}
+	return ctx.JSON(http.StatusOK, *stats)
+}
+
 func (a *ApiHandler) GetTransaction(ctx echo.Context, hash string) error {
 	txHash := strings.ToLower(hash)
 
@@ -77,15 +173,25 @@ func (a *ApiHandler) GetTransaction(ctx echo.Context, hash string) error {
 	if tx.Hash == "" {
 		return ctx.JSON(http.StatusNotFound, "Transaction not found")
 	}
-
-	err := transaction.DecodeTransaction(&tx)
-	if err != nil {
-		log.WithError(err).WithField("hash", hash).Error("Error processing transaction")
-	}
+	transaction.DecodeStoredMethod(&tx)
 
 	return ctx.JSON(http.StatusOK, tx)
 }
 
+// GetTransactionTrace returns calls tree of the transaction with decoded method names
+func (a *ApiHandler) GetTransactionTrace(ctx echo.Context, hash HashParam) error {
+	trace := a.storage.GetCallTrace(strings.ToLower(hash))
+	if trace == nil {
+		return ctx.JSON(http.StatusNotFound, "Transaction trace not found")
+	}
+	root := trace.ToModel()
+	if root == nil {
+		return ctx.JSON(http.StatusNotFound, "Transaction trace not found")
+	}
+	transaction.DecodeTraceCall(root)
+	return ctx.JSON(http.StatusOK, root)
+}
+
 // GetAddressDags returns all DAG blocks sent by the selected address
 func (a *ApiHandler) GetAddressDags(ctx echo.Context, address AddressFilter, params GetAddressDagsParams) error {
 	return ctx.JSON(http.StatusOK, GetAddressDataPage[Dag](a, address, &params.Pagination))
@@ -98,7 +204,27 @@ func (a *ApiHandler) GetAddressPbfts(ctx echo.Context, address AddressFilter, pa
 
 // GetAddressTransactions returns all transactions from and to the selected address
 func (a *ApiHandler) GetAddressTransactions(ctx echo.Context, address AddressFilter, params GetAddressTransactionsParams) error {
-	return ctx.JSON(http.StatusOK, GetAddressDataPage[storage.Transaction](a, address, &params.Pagination))
+	if params.Method == nil {
+		return ctx.JSON(http.StatusOK, GetAddressDataPage[storage.Transaction](a, address, &params.Pagination))
+	}
+	method, err := contracts.NormalizeSignature(*params.Method)
+	if err != nil {
+		return ctx.JSON(http.StatusBadRequest, err.Error())
+	}
+	ret, pagination := storage.GetMethodTransactionsPage(a.storage, address, method, getPaginationStart(params.Pagination.Start), params.Pagination.Limit)
+	decodeStoredMethods(ret)
+	return ctx.JSON(http.StatusOK, struct {
+		PaginatedResponse
+		Data []storage.Transaction `json:"data"`
+	}{
+		PaginatedResponse: *pagination,
+		Data:              ret,
+	})
+}
+
+// GetAddressMethods returns number of calls of the contract methods
+func (a *ApiHandler) GetAddressMethods(ctx echo.Context, address AddressFilter) error {
+	return ctx.JSON(http.StatusOK, a.storage.GetContractMethods(address).ToModel())
 }
 
 // GetAddressPbftTotal returns total number of PBFT blocks produced for the selected address
@@ -114,7 +240,17 @@ func (a *ApiHandler) GetValidators(ctx echo.Context, params GetValidatorsParams)
 	log.WithField("params", params).Debug("GetValidators")
 	year, week := getYearWeek(params.Week)
 	stats := a.storage.GetWeekStats(year, week)
-	ret, pagination := stats.GetPaginated(getPaginationStart(params.Pagination.Start), params.Pagination.Limit)
+	sortBy := storage.SortByPbft
+	if params.SortBy != nil {
+		sortBy = string(*params.SortBy)
+	}
+	// yield is needed for all validators to rank them by it
+	validators := stats.GetValidators()
+	if sortBy == storage.SortByYield {
+		a.setValidatorsYield(validators, year, week)
+	}
+	storage.SortValidators(validators, sortBy, params.Order != nil && *params.Order == Asc)
+	ret, pagination := storage.PaginateValidators(validators, getPaginationStart(params.Pagination.Start), params.Pagination.Limit)
 
 	date, _ := goment.New()
 	date.SetISOWeek(int(week))
@@ -130,15 +266,8 @@ func (a *ApiHandler) GetValidators(ctx echo.Context, params GetValidatorsParams)
 		HasNext:   tn.ISOWeekYear() != int(year) || tn.ISOWeek() != int(week),
 	}
 
-	// return last yield only for the last week stats
-	last_year, last_week := getYearWeek(nil)
-	if last_week == week && last_year == year {
-		for i := 0; i < len(ret); i++ {
-			resp, err := a.getAddressYield(ret[i].Address, nil)
-			if err == nil {
-				ret[i].Yield = resp.Yield
-			}
-		}
+	if sortBy != storage.SortByYield {
+		a.setValidatorsYield(ret, year, week)
 	}
 
 	response := struct {
@@ -154,6 +283,26 @@ func (a *ApiHandler) GetValidators(ctx echo.Context, params GetValidatorsParams)
 	return ctx.JSON(http.StatusOK, response)
 }
 
+// setValidatorsYield sets the last yield for the current week and the yield saved on the week finalization for previous weeks
+func (a *ApiHandler) setValidatorsYield(validators []Validator, year, week int32) {
+	last_year, last_week := getYearWeek(nil)
+	if last_week == week && last_year == year {
+		for i := 0; i < len(validators); i++ {
+			resp, err := a.getAddressYield(validators[i].Address, nil)
+			if err == nil {
+				validators[i].Yield = resp.Yield
+			}
+		}
+		return
+	}
+	weekKey := storage.FormatWeek(year, week)
+	for i := 0; i < len(validators); i++ {
+		if history := storage.GetValidatorHistory(a.storage, strings.ToLower(validators[i].Address), weekKey, weekKey); len(history) > 0 {
+			validators[i].Yield = history[0].Yield
+		}
+	}
+}
+
 // GetValidatorsTotal returns total number of PBFT blocks produced in selected week
 func (a *ApiHandler) GetValidatorsTotal(ctx echo.Context, params GetValidatorsTotalParams) error {
 	log.WithField("params", params).Debug("GetValidatorsTotal")
@@ -178,13 +327,13 @@ func (a *ApiHandler) GetValidator(ctx echo.Context, address AddressParam, params
 
 	year, week := getYearWeek(params.Week)
 	stats := a.storage.GetWeekStats(year, week)
-	stats.Sort()
+	validators := stats.GetValidators()
+	storage.SortValidators(validators, storage.SortByPbft, false)
 
-	validator := Validator{Address: address}
+	validator := Validator{Address: address, Stake: "0", Rewards: "0"}
 
-	for k, v := range stats.Validators {
+	for _, v := range validators {
 		if v.Address == address {
-			v.Rank = uint64(k + 1)
 			validator = v
 			break
 		}
@@ -204,18 +353,433 @@ func (a *ApiHandler) GetValidator(ctx echo.Context, address AddressParam, params
 	return ctx.JSON(http.StatusOK, validator)
 }
 
+// GetValidatorHistory returns weekly stats of the validator for the selected range of weeks
+func (a *ApiHandler) GetValidatorHistory(ctx echo.Context, address AddressParam, params GetValidatorHistoryParams) error {
+	address = strings.ToLower(address)
+	log.WithField("address", address).WithField("params", params).Debug("GetValidatorHistory")
+
+	tn, _ := goment.New()
+	currentYear, currentWeek := int32(tn.ISOWeekYear()), int32(tn.ISOWeek())
+	currentWeekKey := storage.FormatWeek(currentYear, currentWeek)
+
+	toWeek := currentWeekKey
+	if params.ToWeek != nil {
+		toWeek = uint64(*params.ToWeek)
+	}
+	from, _ := goment.New()
+	from.Subtract(51, "weeks")
+	fromWeek := storage.FormatWeek(int32(from.ISOWeekYear()), int32(from.ISOWeek()))
+	if params.FromWeek != nil {
+		fromWeek = uint64(*params.FromWeek)
+	}
+	if fromWeek > toWeek {
+		return ctx.JSON(http.StatusBadRequest, "fromWeek should be less than or equal to toWeek")
+	}
+
+	ret := ValidatorHistoryResponse{Data: storage.GetValidatorHistory(a.storage, address, fromWeek, toWeek)}
+
+	// current week isn't finished yet, so it is calculated from the week stats
+	if fromWeek <= currentWeekKey && currentWeekKey <= toWeek {
+		stats := a.storage.GetWeekStats(currentYear, currentWeek)
+		for k, v := range stats.GetValidatorsWeekStats(currentYear, currentWeek) {
+			if stats.Validators[k].Address != address {
+				continue
+			}
+			if resp, err := a.getAddressYield(address, nil); err == nil {
+				v.Yield = resp.Yield
+			}
+			if l := len(ret.Data); l > 0 && storage.FormatWeek(int32(ret.Data[l-1].Year), int32(ret.Data[l-1].Week)) == currentWeekKey {
+				ret.Data[l-1] = v
+			} else {
+				ret.Data = append(ret.Data, v)
+			}
+			break
+		}
+	}
+
+	return ctx.JSON(http.StatusOK, ret)
+}
+
+// GetValidatorVotes returns cert votes participation of the validator aggregated by days or weeks
+func (a *ApiHandler) GetValidatorVotes(ctx echo.Context, address AddressParam, params GetValidatorVotesParams) error {
+	address = strings.ToLower(address)
+	log.WithField("address", address).WithField("params", params).Debug("GetValidatorVotes")
+
+	to := uint64(time.Now().Unix())
+	if params.ToTimestamp != nil {
+		to = *params.ToTimestamp
+	}
+
+	var o interface{} = new(storage.DailyVoteStats)
+	getStart, defaultRange := storage.GetDayStart, uint64(29*24*60*60)
+	if params.Window != nil && *params.Window == GetValidatorVotesParamsWindowWeek {
+		o = new(storage.WeeklyVoteStats)
+		getStart, defaultRange = storage.GetWeekStart, uint64(11*7*24*60*60)
+	}
+	from := getStart(to) - defaultRange
+	if params.FromTimestamp != nil {
+		// include the window that contains the specified timestamp
+		from = getStart(*params.FromTimestamp)
+	}
+
+	return ctx.JSON(http.StatusOK, VoteStatsResponse{Data: storage.GetVoteStats(a.storage, o, address, from, to)})
+}
+
+// GetPeriodDag returns DAG blocks graph of the period in the finalization order
+func (a *ApiHandler) GetPeriodDag(ctx echo.Context, period Uint64) error {
+	graph := a.storage.GetDagGraph(period)
+	if graph == nil {
+		return ctx.JSON(http.StatusNotFound, "DAG graph not found")
+	}
+	return ctx.JSON(http.StatusOK, graph.ToModel())
+}
+
+// GetValidatorDagStats returns weekly DAG blocks production stats of the validator
+func (a *ApiHandler) GetValidatorDagStats(ctx echo.Context, address AddressParam, params GetValidatorDagStatsParams) error {
+	address = strings.ToLower(address)
+	log.WithField("address", address).WithField("params", params).Debug("GetValidatorDagStats")
+
+	to := uint64(time.Now().Unix())
+	if params.ToTimestamp != nil {
+		to = *params.ToTimestamp
+	}
+	from := storage.GetWeekStart(to) - uint64(11*7*24*60*60)
+	if params.FromTimestamp != nil {
+		// include the week that contains the specified timestamp
+		from = storage.GetWeekStart(*params.FromTimestamp)
+	}
+
+	return ctx.JSON(http.StatusOK, DagProductionStatsResponse{Data: storage.GetDagProductionStats(a.storage, address, from, to)})
+}
+
+// GetPeriodRewards returns rewards breakdown of the distribution period
+func (a *ApiHandler) GetPeriodRewards(ctx echo.Context, period Uint64) error {
+	rewards := a.storage.GetPeriodRewards(period)
+	if rewards == nil {
+		return ctx.JSON(http.StatusNotFound, "Period rewards not found")
+	}
+	return ctx.JSON(http.StatusOK, rewards.ToModel())
+}
+
+// GetValidatorRewards returns rewards of the validator for the blocks range summed by categories
+func (a *ApiHandler) GetValidatorRewards(ctx echo.Context, address AddressParam, params GetValidatorRewardsParams) error {
+	address = strings.ToLower(address)
+	ret := ValidatorRewardsResponse{ToBlock: a.storage.GetFinalizationData().PbftCount}
+	if params.FromBlock != nil {
+		ret.FromBlock = *params.FromBlock
+	}
+	if params.ToBlock != nil && *params.ToBlock < ret.ToBlock {
+		ret.ToBlock = *params.ToBlock
+	}
+
+	rewards, count := storage.GetValidatorRewards(a.storage, address, ret.FromBlock, ret.ToBlock)
+	ret.PeriodsCount = count
+	ret.Rewards = rewards.ToModel()
+	return ctx.JSON(http.StatusOK, ret)
+}
+
+// GetDailyStats returns network stats aggregated by days
+func (a *ApiHandler) GetDailyStats(ctx echo.Context, params GetDailyStatsParams) error {
+	to := uint64(time.Now().Unix())
+	if params.ToTimestamp != nil {
+		to = *params.ToTimestamp
+	}
+	from := storage.GetDayStart(to) - 29*24*60*60
+	if params.FromTimestamp != nil {
+		from = storage.GetDayStart(*params.FromTimestamp)
+	}
+	return ctx.JSON(http.StatusOK, NetworkStatsResponse{Data: storage.GetNetworkStatsSeries(a.storage, new(storage.DailyNetworkStats), from, to)})
+}
+
+// GetInclusionStats returns transactions inclusion latency of the periods
+func (a *ApiHandler) GetInclusionStats(ctx echo.Context, params GetInclusionStatsParams) error {
+	to := a.storage.GetFinalizationData().PbftCount
+	if params.ToBlock != nil && *params.ToBlock < to {
+		to = *params.ToBlock
+	}
+	from := uint64(0)
+	if to > 99 {
+		from = to - 99
+	}
+	if params.FromBlock != nil {
+		from = *params.FromBlock
+	}
+	return ctx.JSON(http.StatusOK, InclusionStatsResponse{Data: storage.GetInclusionStatsSeries(a.storage, new(storage.PeriodInclusionStats), from, to)})
+}
+
+// GetDailyInclusionStats returns transactions inclusion latency aggregated by days
+func (a *ApiHandler) GetDailyInclusionStats(ctx echo.Context, params GetDailyInclusionStatsParams) error {
+	to := uint64(time.Now().Unix())
+	if params.ToTimestamp != nil {
+		to = *params.ToTimestamp
+	}
+	from := storage.GetDayStart(to) - 29*24*60*60
+	if params.FromTimestamp != nil {
+		from = storage.GetDayStart(*params.FromTimestamp)
+	}
+	return ctx.JSON(http.StatusOK, InclusionStatsResponse{Data: storage.GetInclusionStatsSeries(a.storage, new(storage.DailyInclusionStats), from, to)})
+}
+
+// GetMonthlyStats returns network stats aggregated by months
+func (a *ApiHandler) GetMonthlyStats(ctx echo.Context, params GetMonthlyStatsParams) error {
+	to := uint64(time.Now().Unix())
+	if params.ToTimestamp != nil {
+		to = *params.ToTimestamp
+	}
+	from := uint64(time.Unix(int64(storage.GetMonthStart(to)), 0).UTC().AddDate(0, -11, 0).Unix())
+	if params.FromTimestamp != nil {
+		from = storage.GetMonthStart(*params.FromTimestamp)
+	}
+	return ctx.JSON(http.StatusOK, NetworkStatsResponse{Data: storage.GetNetworkStatsSeries(a.storage, new(storage.MonthlyNetworkStats), from, to)})
+}
+
+// GetValidatorSet returns validators with their stakes at the specified block, the last indexed block by default
+func (a *ApiHandler) GetValidatorSet(ctx echo.Context, params GetValidatorSetParams) error {
+	block := a.storage.GetFinalizationData().PbftCount
+	if params.BlockNumber != nil && *params.BlockNumber < block {
+		block = *params.BlockNumber
+	}
+	set := storage.GetValidatorSet(a.storage, block)
+	return ctx.JSON(http.StatusOK, set.ToModel())
+}
+
+// GetEligibleValidators returns validators which stake meets the eligibility threshold at the block
+func (a *ApiHandler) GetEligibleValidators(ctx echo.Context, params GetEligibleValidatorsParams) error {
+	if a.config.GetEligibilityThreshold() == nil {
+		return ctx.JSON(http.StatusNotFound, "Eligibility threshold not found")
+	}
+	block := a.storage.GetFinalizationData().PbftCount
+	if params.BlockNumber != nil && *params.BlockNumber < block {
+		block = *params.BlockNumber
+	}
+	set := storage.GetValidatorSet(a.storage, block)
+	return ctx.JSON(http.StatusOK, storage.GetEligibleValidators(a.storage, a.config, &set))
+}
+
+// GetValidatorEligibilityHistory returns blocks where the validator stake crossed the eligibility threshold
+func (a *ApiHandler) GetValidatorEligibilityHistory(ctx echo.Context, address AddressParam, params GetValidatorEligibilityHistoryParams) error {
+	if a.config.GetEligibilityThreshold() == nil {
+		return ctx.JSON(http.StatusNotFound, "Eligibility threshold not found")
+	}
+	from, to := uint64(0), a.storage.GetFinalizationData().PbftCount
+	if params.FromBlock != nil {
+		from = *params.FromBlock
+	}
+	if params.ToBlock != nil && *params.ToBlock < to {
+		to = *params.ToBlock
+	}
+	return ctx.JSON(http.StatusOK, EligibilityHistoryResponse{Data: storage.GetEligibilityChanges(a.storage, a.config, address, from, to)})
+}
+
+// GetValidatorStakeHistory returns changes of the validator stake in the block range
+func (a *ApiHandler) GetValidatorStakeHistory(ctx echo.Context, address AddressParam, params GetValidatorStakeHistoryParams) error {
+	from, to := uint64(0), a.storage.GetFinalizationData().PbftCount
+	if params.FromBlock != nil {
+		from = *params.FromBlock
+	}
+	if params.ToBlock != nil && *params.ToBlock < to {
+		to = *params.ToBlock
+	}
+	return ctx.JSON(http.StatusOK, ValidatorStakeHistoryResponse{Data: storage.GetValidatorStakeHistory(a.storage, address, from, to)})
+}
+
 func (a *ApiHandler) GetTotalSupply(ctx echo.Context) error {
 	return ctx.JSON(http.StatusOK, a.storage.GetTotalSupply().String())
 }
 
+// GetSupply returns supply breakdown with the configured locked addresses
+func (a *ApiHandler) GetSupply(ctx echo.Context) error {
+	return ctx.JSON(http.StatusOK, storage.GetSupply(a.storage, a.config.LockedAddresses))
+}
+
+func (a *ApiHandler) getSupplySnapshots(fromBlock, toBlock *Uint64) []storage.SupplySnapshot {
+	from, to := uint64(0), a.storage.GetFinalizationData().PbftCount
+	if fromBlock != nil {
+		from = *fromBlock
+	}
+	if toBlock != nil && *toBlock < to {
+		to = *toBlock
+	}
+	return storage.GetSupplySnapshots(a.storage, from, to)
+}
+
+// GetSupplyHistory returns total supply and minted amount saved at the end of every supply saving interval
+func (a *ApiHandler) GetSupplyHistory(ctx echo.Context, params GetSupplyHistoryParams) error {
+	snapshots := a.getSupplySnapshots(params.FromBlock, params.ToBlock)
+	ret := SupplyHistoryResponse{Data: make([]SupplySnapshot, 0, len(snapshots))}
+	for _, s := range snapshots {
+		ret.Data = append(ret.Data, s.ToSupplyModel())
+	}
+	return ctx.JSON(http.StatusOK, ret)
+}
+
+// GetStakingHistory returns total delegated amount and staking ratio saved at the end of every supply saving interval
+func (a *ApiHandler) GetStakingHistory(ctx echo.Context, params GetStakingHistoryParams) error {
+	snapshots := a.getSupplySnapshots(params.FromBlock, params.ToBlock)
+	ret := StakingHistoryResponse{Data: make([]StakingSnapshot, 0, len(snapshots))}
+	for _, s := range snapshots {
+		ret.Data = append(ret.Data, s.ToStakingModel())
+	}
+	return ctx.JSON(http.StatusOK, ret)
+}
+
+// GetCirculatingSupply returns circulating supply as a single value as it is required by the coin listing services
+func (a *ApiHandler) GetCirculatingSupply(ctx echo.Context) error {
+	return ctx.JSON(http.StatusOK, storage.GetSupply(a.storage, a.config.LockedAddresses).CirculatingSupply)
+}
+
 func (a *ApiHandler) GetInternalTransactions(ctx echo.Context, hash HashParam) error {
-	return ctx.JSON(http.StatusOK, a.storage.GetInternalTransactions(hash))
+	internal := a.storage.GetInternalTransactions(hash)
+	decodeStoredMethods(internal.Data)
+	return ctx.JSON(http.StatusOK, internal)
 }
 
 func (a *ApiHandler) GetTransactionLogs(ctx echo.Context, hash HashParam) error {
 	return ctx.JSON(http.StatusOK, a.storage.GetTransactionLogs(hash))
 }
 
+func makeDelegationsResponse(delegations []storage.Delegation, total *big.Int) DelegationsResponse {
+	ret := DelegationsResponse{Total: total.String(), Data: make([]Delegation, 0, len(delegations))}
+	for _, d := range delegations {
+		ret.Data = append(ret.Data, d.ToModel())
+	}
+	return ret
+}
+
+// GetAddressDelegations returns current delegations of the address
+func (a *ApiHandler) GetAddressDelegations(ctx echo.Context, address AddressParam) error {
+	return ctx.JSON(http.StatusOK, makeDelegationsResponse(storage.GetDelegations(a.storage, address)))
+}
+
+// GetValidatorDelegators returns current delegations to the validator
+func (a *ApiHandler) GetValidatorDelegators(ctx echo.Context, address AddressParam) error {
+	return ctx.JSON(http.StatusOK, makeDelegationsResponse(storage.GetDelegators(a.storage, address)))
+}
+
+// GetAddressUndelegations returns pending undelegations of the address
+func (a *ApiHandler) GetAddressUndelegations(ctx echo.Context, address AddressParam) error {
+	lockingPeriod := uint64(0)
+	if a.config.Chain != nil {
+		lockingPeriod = a.config.Chain.DelegationLockingPeriod
+	}
+	currentBlock := a.storage.GetFinalizationData().PbftCount
+
+	undelegations := storage.GetUndelegations(a.storage, address)
+	ret := UndelegationsResponse{Data: make([]Undelegation, 0, len(undelegations))}
+	total := big.NewInt(0)
+	for _, u := range undelegations {
+		ret.Data = append(ret.Data, u.ToModel(lockingPeriod, currentBlock))
+		total.Add(total, u.Amount)
+	}
+	ret.Total = total.String()
+	return ctx.JSON(http.StatusOK, ret)
+}
+
+// GetAddressDelegationsHistory returns delegation events of the address
+func (a *ApiHandler) GetAddressDelegationsHistory(ctx echo.Context, address AddressParam, params GetAddressDelegationsHistoryParams) error {
+	return ctx.JSON(http.StatusOK, storage.GetDelegationEventsPage(a.storage, address, getPaginationStart(params.Pagination.Start), params.Pagination.Limit))
+}
+
+// GetValidatorProfile returns current owner, commission and info of the validator
+func (a *ApiHandler) GetValidatorProfile(ctx echo.Context, address AddressParam) error {
+	profile := a.storage.GetValidatorProfile(address)
+	if profile == nil {
+		return ctx.JSON(http.StatusNotFound, "Validator not found")
+	}
+	return ctx.JSON(http.StatusOK, ValidatorProfile(*profile))
+}
+
+// GetValidatorEvents returns profile change history of the validator
+func (a *ApiHandler) GetValidatorEvents(ctx echo.Context, address AddressParam, params GetValidatorEventsParams) error {
+	return ctx.JSON(http.StatusOK, storage.GetValidatorEventsPage(a.storage, address, getPaginationStart(params.Pagination.Start), params.Pagination.Limit))
+}
+
+// GetLogs returns logs emitted by the contract and/or with the specified topics
+func (a *ApiHandler) GetLogs(ctx echo.Context, params GetLogsParams) error {
+	if params.Address == nil && params.Topic0 == nil {
+		return ctx.JSON(http.StatusBadRequest, "address or topic0 should be specified")
+	}
+	filter := storage.LogsFilter{
+		Address: params.Address,
+		Topic0:  params.Topic0,
+		Topic1:  params.Topic1,
+		ToBlock: a.storage.GetFinalizationData().PbftCount,
+	}
+	if params.FromBlock != nil {
+		filter.FromBlock = *params.FromBlock
+	}
+	if params.ToBlock != nil && *params.ToBlock < filter.ToBlock {
+		filter.ToBlock = *params.ToBlock
+	}
+
+	ret := storage.GetLogsPage(a.storage, filter, getPaginationStart(params.Pagination.Start), params.Pagination.Limit)
+	for i, l := range ret.Data {
+		name, decoded, guess, err := events.DecodeEvent(EventLog{Address: l.Address, Data: l.Data, Topics: l.Topics})
+		if err != nil {
+			log.WithError(err).WithField("hash", l.TransactionHash).Debug("Failed to decode log")
+		}
+		ret.Data[i].Name = name
+		ret.Data[i].Params = decoded
+		if guess {
+			ret.Data[i].Guess = &guess
+		}
+	}
+	return ctx.JSON(http.StatusOK, ret)
+}
+
+func (a *ApiHandler) GetContractAbi(ctx echo.Context, address AddressParam) error {
+	raw := contracts.Abis.GetRaw(address)
+	if raw == "" {
+		return ctx.JSON(http.StatusNotFound, "Contract ABI not found")
+	}
+	return ctx.JSONBlob(http.StatusOK, []byte(raw))
+}
+
+func (a *ApiHandler) PutContractAbi(ctx echo.Context, address AddressParam) error {
+	body, err := io.ReadAll(ctx.Request().Body)
+	if err != nil {
+		return err
+	}
+	err = transaction.SaveContractABI(a.storage, contracts.Abis, address, string(body))
+	if err != nil {
+		return ctx.JSON(http.StatusBadRequest, err.Error())
+	}
+	log.WithField("address", address).Info("Contract ABI saved")
+	return ctx.NoContent(http.StatusOK)
+}
+
+// PostSignatures adds function and event signatures that are used to decode calls and logs of contracts without ABI
+func (a *ApiHandler) PostSignatures(ctx echo.Context) error {
+	var signatures Signatures
+	if err := ctx.Bind(&signatures); err != nil {
+		return ctx.JSON(http.StatusBadRequest, err.Error())
+	}
+	saved, err := storage.SaveSignatures(a.storage, contracts.Signatures, signatures)
+	if err != nil {
+		return ctx.JSON(http.StatusBadRequest, err.Error())
+	}
+	log.WithField("count", len(saved)).Info("Signatures saved")
+	return ctx.JSON(http.StatusOK, saved)
+}
+
+// GetSignatures returns known function signatures for 4-byte selector or event signatures for topic hash
+func (a *ApiHandler) GetSignatures(ctx echo.Context, hash string) error {
+	data, err := hexutil.Decode(hash)
+	if err != nil {
+		return ctx.JSON(http.StatusBadRequest, err.Error())
+	}
+	ret := Signatures{}
+	switch len(data) {
+	case 4:
+		ret = append(ret, contracts.Signatures.GetMethods(data)...)
+	case ethcommon.HashLength:
+		ret = append(ret, contracts.Signatures.GetEvents(ethcommon.BytesToHash(data))...)
+	default:
+		return ctx.JSON(http.StatusBadRequest, "Hash should be 4-byte selector or 32-byte topic")
+	}
+	return ctx.JSON(http.StatusOK, ret)
+}
+
 func (a *ApiHandler) getAddressYield(address AddressParam, block *uint64) (resp *YieldResponse, err error) {
 	pbft_count := a.storage.GetFinalizationData().PbftCount
 	block_num := common.GetYieldIntervalEnd(pbft_count, block, a.config.ValidatorsYieldSavingInterval)
@@ -0,0 +1,71 @@
+package api
+
+import (
+	"encoding/json"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/dailycrypto-me/daily-indexer/internal/chain"
+	"github.com/dailycrypto-me/daily-indexer/internal/common"
+	"github.com/dailycrypto-me/daily-indexer/internal/contracts"
+	"github.com/dailycrypto-me/daily-indexer/internal/storage"
+	"github.com/dailycrypto-me/daily-indexer/internal/storage/pebble"
+	"github.com/dailycrypto-me/daily-indexer/internal/transaction"
+	. "github.com/dailycrypto-me/daily-indexer/models"
+	ethcommon "github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+)
+
+const setValueAbi = `[{"inputs":[{"name":"value","type":"uint256"}],"name":"setValue","outputs":[],"stateMutability":"nonpayable","type":"function"}]`
+
+func request(t *testing.T, body string, handle func(ctx echo.Context) error) *httptest.ResponseRecorder {
+	rec := httptest.NewRecorder()
+	ctx := echo.New().NewContext(httptest.NewRequest(http.MethodGet, "/", strings.NewReader(body)), rec)
+	assert.NoError(t, handle(ctx))
+	return rec
+}
+
+func TestContractABIAppliedToIndexedCalls(t *testing.T) {
+	defaultAbis := contracts.Abis
+	contracts.Abis = contracts.MakeDefaultRegistry()
+	t.Cleanup(func() { contracts.Abis = defaultAbis })
+
+	st := pebble.NewStorage("")
+	defer st.Close()
+	a := NewApiHandler(st, common.DefaultConfig(), chain.MakeStats(1))
+
+	contract, sender := "0x0000000000000000000000000000000000000abd", "0x0000000000000000000000000000000000000001"
+	method := "setValue(uint256)"
+	input := append(crypto.Keccak256([]byte(method))[:4], ethcommon.LeftPadBytes(big.NewInt(5).Bytes(), 32)...)
+	trx := storage.Transaction{Hash: "0x01", From: sender, To: contract, Input: hexutil.Encode(input), Type: ContractCall, Value: big.NewInt(0), GasCost: big.NewInt(0)}
+
+	// call is indexed before the ABI is uploaded, so it isn't decoded
+	transaction.DecodeMethod(&trx)
+	assert.Nil(t, trx.Calldata)
+	b := st.NewBatch()
+	b.AddSingleKey(trx, trx.Hash)
+	b.Add(trx, sender, 1)
+	b.Add(trx, contract, 1)
+	b.CommitBatch()
+
+	rec := request(t, setValueAbi, func(ctx echo.Context) error { return a.PutContractAbi(ctx, contract) })
+	assert.Equal(t, http.StatusOK, rec.Code)
+
+	var tx storage.Transaction
+	rec = request(t, "", func(ctx echo.Context) error { return a.GetTransaction(ctx, trx.Hash) })
+	assert.NoError(t, json.Unmarshal(rec.Body.Bytes(), &tx))
+	assert.Equal(t, method, tx.Method)
+	assert.Equal(t, []any{"5"}, tx.Calldata.Params)
+
+	page, pagination := storage.GetMethodTransactionsPage(st, contract, method, 0, 10)
+	assert.Len(t, page, 1)
+	assert.Equal(t, uint64(1), pagination.Total)
+	assert.Equal(t, method, page[0].Calldata.Name)
+	assert.Equal(t, uint64(1), st.GetContractMethods(contract).GetCount(method))
+}
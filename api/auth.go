@@ -0,0 +1,28 @@
+package api
+
+import (
+	"context"
+	"crypto/subtle"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/getkin/kin-openapi/openapi3filter"
+	"github.com/labstack/echo/v4"
+)
+
+// MakeTokenAuthenticator returns a function that checks bearer token for the endpoints with apiToken security requirement.
+// If token is empty, all requests to such endpoints are rejected
+func MakeTokenAuthenticator(token string) openapi3filter.AuthenticationFunc {
+	return func(_ context.Context, input *openapi3filter.AuthenticationInput) error {
+		if token == "" {
+			return echo.NewHTTPError(http.StatusForbidden, "API token isn't configured")
+		}
+		header := input.RequestValidationInput.Request.Header.Get(echo.HeaderAuthorization)
+		provided, found := strings.CutPrefix(header, "Bearer ")
+		if !found || subtle.ConstantTimeCompare([]byte(provided), []byte(token)) != 1 {
+			return echo.NewHTTPError(http.StatusUnauthorized, fmt.Sprintf("invalid %s token", input.SecuritySchemeName))
+		}
+		return nil
+	}
+}
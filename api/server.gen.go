@@ -24,6 +24,15 @@ type ServerInterface interface {
 	// Returns all DAG blocks
 	// (GET /address/{address}/dags)
 	GetAddressDags(ctx echo.Context, address AddressParam, params GetAddressDagsParams) error
+	// Returns delegations of the address
+	// (GET /address/{address}/delegations)
+	GetAddressDelegations(ctx echo.Context, address AddressParam) error
+	// Returns delegation history of the address
+	// (GET /address/{address}/delegations/history)
+	GetAddressDelegationsHistory(ctx echo.Context, address AddressParam, params GetAddressDelegationsHistoryParams) error
+	// Returns contract methods calls count
+	// (GET /address/{address}/methods)
+	GetAddressMethods(ctx echo.Context, address AddressParam) error
 	// Returns all PBFT blocks
 	// (GET /address/{address}/pbfts)
 	GetAddressPbfts(ctx echo.Context, address AddressParam, params GetAddressPbftsParams) error
@@ -33,6 +42,9 @@ type ServerInterface interface {
 	// Returns all transactions
 	// (GET /address/{address}/transactions)
 	GetAddressTransactions(ctx echo.Context, address AddressParam, params GetAddressTransactionsParams) error
+	// Returns pending undelegations of the address
+	// (GET /address/{address}/undelegations)
+	GetAddressUndelegations(ctx echo.Context, address AddressParam) error
 	// Returns yield for the address
 	// (GET /address/{address}/yield)
 	GetAddressYield(ctx echo.Context, address AddressParam, params GetAddressYieldParams) error
@@ -41,10 +53,67 @@ type ServerInterface interface {
 	GetAddressYieldForInterval(ctx echo.Context, address AddressParam, params GetAddressYieldForIntervalParams) error
 	// Returns chain stats
 	// (GET /chainStats)
-	GetChainStats(ctx echo.Context) error
+	GetChainStats(ctx echo.Context, params GetChainStatsParams) error
+	// Returns chain stats history
+	// (GET /chainStats/history)
+	GetChainStatsHistory(ctx echo.Context, params GetChainStatsHistoryParams) error
+	// Returns circulating supply
+	// (GET /circulatingSupply)
+	GetCirculatingSupply(ctx echo.Context) error
+	// Returns contract ABI
+	// (GET /contracts/{address}/abi)
+	GetContractAbi(ctx echo.Context, address AddressParam) error
+	// Uploads contract ABI
+	// (PUT /contracts/{address}/abi)
+	PutContractAbi(ctx echo.Context, address AddressParam) error
+	// Returns gas price stats and suggested prices
+	// (GET /gas)
+	GetGasStats(ctx echo.Context, params GetGasStatsParams) error
+	// Returns gas price stats history
+	// (GET /gas/history)
+	GetGasStatsHistory(ctx echo.Context, params GetGasStatsHistoryParams) error
 	// Returns the list of DLY token holders and their balances
 	// (GET /holders)
 	GetHolders(ctx echo.Context, params GetHoldersParams) error
+	// Searches event logs
+	// (GET /logs)
+	GetLogs(ctx echo.Context, params GetLogsParams) error
+	// Returns DAG blocks graph of the period
+	// (GET /period/{period}/dag)
+	GetPeriodDag(ctx echo.Context, period Uint64) error
+	// Returns rewards distributed in the period
+	// (GET /period/{period}/rewards)
+	GetPeriodRewards(ctx echo.Context, period Uint64) error
+	// Adds function and event signatures
+	// (POST /signatures)
+	PostSignatures(ctx echo.Context) error
+	// Returns known signatures for selector or topic
+	// (GET /signatures/{hash})
+	GetSignatures(ctx echo.Context, hash string) error
+	// Returns staking history
+	// (GET /staking/history)
+	GetStakingHistory(ctx echo.Context, params GetStakingHistoryParams) error
+	// Returns daily network stats
+	// (GET /stats/daily)
+	GetDailyStats(ctx echo.Context, params GetDailyStatsParams) error
+	// Returns decentralization metrics of the network
+	// (GET /stats/decentralization)
+	GetDecentralizationStats(ctx echo.Context, params GetDecentralizationStatsParams) error
+	// Returns transactions inclusion latency of the periods
+	// (GET /stats/inclusion)
+	GetInclusionStats(ctx echo.Context, params GetInclusionStatsParams) error
+	// Returns daily transactions inclusion latency
+	// (GET /stats/inclusion/daily)
+	GetDailyInclusionStats(ctx echo.Context, params GetDailyInclusionStatsParams) error
+	// Returns monthly network stats
+	// (GET /stats/monthly)
+	GetMonthlyStats(ctx echo.Context, params GetMonthlyStatsParams) error
+	// Returns supply breakdown
+	// (GET /supply)
+	GetSupply(ctx echo.Context) error
+	// Returns supply history
+	// (GET /supply/history)
+	GetSupplyHistory(ctx echo.Context, params GetSupplyHistoryParams) error
 	// Returns total supply
 	// (GET /totalSupply)
 	GetTotalSupply(ctx echo.Context) error
@@ -60,15 +129,51 @@ type ServerInterface interface {
 	// Returns event logs of transaction
 	// (GET /transaction/{hash}/logs)
 	GetTransactionLogs(ctx echo.Context, hash HashParam) error
+	// Returns calls tree of transaction
+	// (GET /transaction/{hash}/trace)
+	GetTransactionTrace(ctx echo.Context, hash HashParam) error
 	// Returns all validators
 	// (GET /validators)
 	GetValidators(ctx echo.Context, params GetValidatorsParams) error
+	// Returns validators eligible to participate in the consensus
+	// (GET /validators/eligible)
+	GetEligibleValidators(ctx echo.Context, params GetEligibleValidatorsParams) error
+	// Returns validator set at the block
+	// (GET /validators/set)
+	GetValidatorSet(ctx echo.Context, params GetValidatorSetParams) error
 	// Returns total number of PBFT blocks
 	// (GET /validators/total)
 	GetValidatorsTotal(ctx echo.Context, params GetValidatorsTotalParams) error
 	// Returns info about the validator
 	// (GET /validators/{address})
 	GetValidator(ctx echo.Context, address AddressParam, params GetValidatorParams) error
+	// Returns DAG blocks production stats of the validator
+	// (GET /validators/{address}/dags/stats)
+	GetValidatorDagStats(ctx echo.Context, address AddressParam, params GetValidatorDagStatsParams) error
+	// Returns delegators of the validator
+	// (GET /validators/{address}/delegators)
+	GetValidatorDelegators(ctx echo.Context, address AddressParam) error
+	// Returns eligibility changes of the validator
+	// (GET /validators/{address}/eligibility/history)
+	GetValidatorEligibilityHistory(ctx echo.Context, address AddressParam, params GetValidatorEligibilityHistoryParams) error
+	// Returns profile change history of the validator
+	// (GET /validators/{address}/events)
+	GetValidatorEvents(ctx echo.Context, address AddressParam, params GetValidatorEventsParams) error
+	// Returns weekly stats of the validator
+	// (GET /validators/{address}/history)
+	GetValidatorHistory(ctx echo.Context, address AddressParam, params GetValidatorHistoryParams) error
+	// Returns profile of the validator
+	// (GET /validators/{address}/profile)
+	GetValidatorProfile(ctx echo.Context, address AddressParam) error
+	// Returns rewards of the validator
+	// (GET /validators/{address}/rewards)
+	GetValidatorRewards(ctx echo.Context, address AddressParam, params GetValidatorRewardsParams) error
+	// Returns stake history of the validator
+	// (GET /validators/{address}/stake/history)
+	GetValidatorStakeHistory(ctx echo.Context, address AddressParam, params GetValidatorStakeHistoryParams) error
+	// Returns cert votes participation of the validator
+	// (GET /validators/{address}/votes)
+	GetValidatorVotes(ctx echo.Context, address AddressParam, params GetValidatorVotesParams) error
 }
 
 // ServerInterfaceWrapper converts echo contexts to parameters.
@@ -101,6 +206,63 @@ func (w *ServerInterfaceWrapper) GetAddressDags(ctx echo.Context) error {
 	return err
 }
 
+// GetAddressDelegations converts echo context to params.
+func (w *ServerInterfaceWrapper) GetAddressDelegations(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "address" -------------
+	var address AddressParam
+
+	err = runtime.BindStyledParameterWithOptions("simple", "address", ctx.Param("address"), &address, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter address: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetAddressDelegations(ctx, address)
+	return err
+}
+
+// GetAddressDelegationsHistory converts echo context to params.
+func (w *ServerInterfaceWrapper) GetAddressDelegationsHistory(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "address" -------------
+	var address AddressParam
+
+	err = runtime.BindStyledParameterWithOptions("simple", "address", ctx.Param("address"), &address, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter address: %s", err))
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetAddressDelegationsHistoryParams
+	// ------------- Required query parameter "pagination" -------------
+
+	err = runtime.BindQueryParameter("form", true, true, "pagination", ctx.QueryParams(), &params.Pagination)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter pagination: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetAddressDelegationsHistory(ctx, address, params)
+	return err
+}
+
+// GetAddressMethods converts echo context to params.
+func (w *ServerInterfaceWrapper) GetAddressMethods(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "address" -------------
+	var address AddressParam
+
+	err = runtime.BindStyledParameterWithOptions("simple", "address", ctx.Param("address"), &address, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter address: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetAddressMethods(ctx, address)
+	return err
+}
+
 // GetAddressPbfts converts echo context to params.
 func (w *ServerInterfaceWrapper) GetAddressPbfts(ctx echo.Context) error {
 	var err error
@@ -162,11 +324,34 @@ func (w *ServerInterfaceWrapper) GetAddressTransactions(ctx echo.Context) error
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter pagination: %s", err))
 	}
 
+	// ------------- Optional query parameter "method" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "method", ctx.QueryParams(), &params.Method)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter method: %s", err))
+	}
+
 	// Invoke the callback with all the unmarshaled arguments
 	err = w.Handler.GetAddressTransactions(ctx, address, params)
 	return err
 }
 
+// GetAddressUndelegations converts echo context to params.
+func (w *ServerInterfaceWrapper) GetAddressUndelegations(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "address" -------------
+	var address AddressParam
+
+	err = runtime.BindStyledParameterWithOptions("simple", "address", ctx.Param("address"), &address, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter address: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetAddressUndelegations(ctx, address)
+	return err
+}
+
 // GetAddressYield converts echo context to params.
 func (w *ServerInterfaceWrapper) GetAddressYield(ctx echo.Context) error {
 	var err error
@@ -228,115 +413,216 @@ func (w *ServerInterfaceWrapper) GetAddressYieldForInterval(ctx echo.Context) er
 func (w *ServerInterfaceWrapper) GetChainStats(ctx echo.Context) error {
 	var err error
 
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetChainStatsParams
+	// ------------- Optional query parameter "window" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "window", ctx.QueryParams(), &params.Window)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter window: %s", err))
+	}
+
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.GetChainStats(ctx)
+	err = w.Handler.GetChainStats(ctx, params)
 	return err
 }
 
-// GetHolders converts echo context to params.
-func (w *ServerInterfaceWrapper) GetHolders(ctx echo.Context) error {
+// GetChainStatsHistory converts echo context to params.
+func (w *ServerInterfaceWrapper) GetChainStatsHistory(ctx echo.Context) error {
 	var err error
 
 	// Parameter object where we will unmarshal all parameters from the context
-	var params GetHoldersParams
-	// ------------- Required query parameter "pagination" -------------
+	var params GetChainStatsHistoryParams
+	// ------------- Optional query parameter "window" -------------
 
-	err = runtime.BindQueryParameter("form", true, true, "pagination", ctx.QueryParams(), &params.Pagination)
+	err = runtime.BindQueryParameter("form", true, false, "window", ctx.QueryParams(), &params.Window)
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter pagination: %s", err))
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter window: %s", err))
+	}
+
+	// ------------- Optional query parameter "fromBlock" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "fromBlock", ctx.QueryParams(), &params.FromBlock)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter fromBlock: %s", err))
+	}
+
+	// ------------- Optional query parameter "toBlock" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "toBlock", ctx.QueryParams(), &params.ToBlock)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter toBlock: %s", err))
+	}
+
+	// ------------- Optional query parameter "points" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "points", ctx.QueryParams(), &params.Points)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter points: %s", err))
 	}
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.GetHolders(ctx, params)
+	err = w.Handler.GetChainStatsHistory(ctx, params)
 	return err
 }
 
-// GetTotalSupply converts echo context to params.
-func (w *ServerInterfaceWrapper) GetTotalSupply(ctx echo.Context) error {
+// GetCirculatingSupply converts echo context to params.
+func (w *ServerInterfaceWrapper) GetCirculatingSupply(ctx echo.Context) error {
 	var err error
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.GetTotalSupply(ctx)
+	err = w.Handler.GetCirculatingSupply(ctx)
 	return err
 }
 
-// GetTotalYield converts echo context to params.
-func (w *ServerInterfaceWrapper) GetTotalYield(ctx echo.Context) error {
+// GetContractAbi converts echo context to params.
+func (w *ServerInterfaceWrapper) GetContractAbi(ctx echo.Context) error {
 	var err error
+	// ------------- Path parameter "address" -------------
+	var address AddressParam
 
-	// Parameter object where we will unmarshal all parameters from the context
-	var params GetTotalYieldParams
-	// ------------- Optional query parameter "blockNumber" -------------
+	err = runtime.BindStyledParameterWithOptions("simple", "address", ctx.Param("address"), &address, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter address: %s", err))
+	}
 
-	err = runtime.BindQueryParameter("form", true, false, "blockNumber", ctx.QueryParams(), &params.BlockNumber)
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetContractAbi(ctx, address)
+	return err
+}
+
+// PutContractAbi converts echo context to params.
+func (w *ServerInterfaceWrapper) PutContractAbi(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "address" -------------
+	var address AddressParam
+
+	err = runtime.BindStyledParameterWithOptions("simple", "address", ctx.Param("address"), &address, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter blockNumber: %s", err))
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter address: %s", err))
 	}
 
+	ctx.Set(ApiTokenScopes, []string{})
+
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.GetTotalYield(ctx, params)
+	err = w.Handler.PutContractAbi(ctx, address)
 	return err
 }
 
-// GetTransaction converts echo context to params.
-func (w *ServerInterfaceWrapper) GetTransaction(ctx echo.Context) error {
+// GetGasStats converts echo context to params.
+func (w *ServerInterfaceWrapper) GetGasStats(ctx echo.Context) error {
 	var err error
-	// ------------- Path parameter "hash" -------------
-	var hash HashParam
 
-	err = runtime.BindStyledParameterWithOptions("simple", "hash", ctx.Param("hash"), &hash, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetGasStatsParams
+	// ------------- Optional query parameter "blocks" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "blocks", ctx.QueryParams(), &params.Blocks)
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter hash: %s", err))
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter blocks: %s", err))
 	}
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.GetTransaction(ctx, hash)
+	err = w.Handler.GetGasStats(ctx, params)
 	return err
 }
 
-// GetInternalTransactions converts echo context to params.
-func (w *ServerInterfaceWrapper) GetInternalTransactions(ctx echo.Context) error {
+// GetGasStatsHistory converts echo context to params.
+func (w *ServerInterfaceWrapper) GetGasStatsHistory(ctx echo.Context) error {
 	var err error
-	// ------------- Path parameter "hash" -------------
-	var hash HashParam
 
-	err = runtime.BindStyledParameterWithOptions("simple", "hash", ctx.Param("hash"), &hash, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetGasStatsHistoryParams
+	// ------------- Optional query parameter "blocks" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "blocks", ctx.QueryParams(), &params.Blocks)
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter hash: %s", err))
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter blocks: %s", err))
+	}
+
+	// ------------- Optional query parameter "fromBlock" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "fromBlock", ctx.QueryParams(), &params.FromBlock)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter fromBlock: %s", err))
+	}
+
+	// ------------- Optional query parameter "toBlock" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "toBlock", ctx.QueryParams(), &params.ToBlock)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter toBlock: %s", err))
+	}
+
+	// ------------- Optional query parameter "points" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "points", ctx.QueryParams(), &params.Points)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter points: %s", err))
 	}
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.GetInternalTransactions(ctx, hash)
+	err = w.Handler.GetGasStatsHistory(ctx, params)
 	return err
 }
 
-// GetTransactionLogs converts echo context to params.
-func (w *ServerInterfaceWrapper) GetTransactionLogs(ctx echo.Context) error {
+// GetHolders converts echo context to params.
+func (w *ServerInterfaceWrapper) GetHolders(ctx echo.Context) error {
 	var err error
-	// ------------- Path parameter "hash" -------------
-	var hash HashParam
 
-	err = runtime.BindStyledParameterWithOptions("simple", "hash", ctx.Param("hash"), &hash, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetHoldersParams
+	// ------------- Required query parameter "pagination" -------------
+
+	err = runtime.BindQueryParameter("form", true, true, "pagination", ctx.QueryParams(), &params.Pagination)
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter hash: %s", err))
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter pagination: %s", err))
 	}
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.GetTransactionLogs(ctx, hash)
+	err = w.Handler.GetHolders(ctx, params)
 	return err
 }
 
-// GetValidators converts echo context to params.
-func (w *ServerInterfaceWrapper) GetValidators(ctx echo.Context) error {
+// GetLogs converts echo context to params.
+func (w *ServerInterfaceWrapper) GetLogs(ctx echo.Context) error {
 	var err error
 
 	// Parameter object where we will unmarshal all parameters from the context
-	var params GetValidatorsParams
-	// ------------- Optional query parameter "week" -------------
+	var params GetLogsParams
+	// ------------- Optional query parameter "address" -------------
 
-	err = runtime.BindQueryParameter("form", true, false, "week", ctx.QueryParams(), &params.Week)
+	err = runtime.BindQueryParameter("form", true, false, "address", ctx.QueryParams(), &params.Address)
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter week: %s", err))
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter address: %s", err))
+	}
+
+	// ------------- Optional query parameter "topic0" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "topic0", ctx.QueryParams(), &params.Topic0)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter topic0: %s", err))
+	}
+
+	// ------------- Optional query parameter "topic1" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "topic1", ctx.QueryParams(), &params.Topic1)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter topic1: %s", err))
+	}
+
+	// ------------- Optional query parameter "fromBlock" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "fromBlock", ctx.QueryParams(), &params.FromBlock)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter fromBlock: %s", err))
+	}
+
+	// ------------- Optional query parameter "toBlock" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "toBlock", ctx.QueryParams(), &params.ToBlock)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter toBlock: %s", err))
 	}
 
 	// ------------- Required query parameter "pagination" -------------
@@ -347,50 +633,715 @@ func (w *ServerInterfaceWrapper) GetValidators(ctx echo.Context) error {
 	}
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.GetValidators(ctx, params)
+	err = w.Handler.GetLogs(ctx, params)
 	return err
 }
 
-// GetValidatorsTotal converts echo context to params.
-func (w *ServerInterfaceWrapper) GetValidatorsTotal(ctx echo.Context) error {
+// GetPeriodDag converts echo context to params.
+func (w *ServerInterfaceWrapper) GetPeriodDag(ctx echo.Context) error {
 	var err error
+	// ------------- Path parameter "period" -------------
+	var period Uint64
 
-	// Parameter object where we will unmarshal all parameters from the context
-	var params GetValidatorsTotalParams
-	// ------------- Optional query parameter "week" -------------
+	err = runtime.BindStyledParameterWithOptions("simple", "period", ctx.Param("period"), &period, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter period: %s", err))
+	}
 
-	err = runtime.BindQueryParameter("form", true, false, "week", ctx.QueryParams(), &params.Week)
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetPeriodDag(ctx, period)
+	return err
+}
+
+// GetPeriodRewards converts echo context to params.
+func (w *ServerInterfaceWrapper) GetPeriodRewards(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "period" -------------
+	var period Uint64
+
+	err = runtime.BindStyledParameterWithOptions("simple", "period", ctx.Param("period"), &period, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter week: %s", err))
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter period: %s", err))
 	}
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.GetValidatorsTotal(ctx, params)
+	err = w.Handler.GetPeriodRewards(ctx, period)
 	return err
 }
 
-// GetValidator converts echo context to params.
-func (w *ServerInterfaceWrapper) GetValidator(ctx echo.Context) error {
+// PostSignatures converts echo context to params.
+func (w *ServerInterfaceWrapper) PostSignatures(ctx echo.Context) error {
 	var err error
-	// ------------- Path parameter "address" -------------
-	var address AddressParam
 
-	err = runtime.BindStyledParameterWithOptions("simple", "address", ctx.Param("address"), &address, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	ctx.Set(ApiTokenScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PostSignatures(ctx)
+	return err
+}
+
+// GetSignatures converts echo context to params.
+func (w *ServerInterfaceWrapper) GetSignatures(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "hash" -------------
+	var hash string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "hash", ctx.Param("hash"), &hash, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter address: %s", err))
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter hash: %s", err))
 	}
 
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetSignatures(ctx, hash)
+	return err
+}
+
+// GetStakingHistory converts echo context to params.
+func (w *ServerInterfaceWrapper) GetStakingHistory(ctx echo.Context) error {
+	var err error
+
 	// Parameter object where we will unmarshal all parameters from the context
-	var params GetValidatorParams
-	// ------------- Optional query parameter "week" -------------
+	var params GetStakingHistoryParams
+	// ------------- Optional query parameter "fromBlock" -------------
 
-	err = runtime.BindQueryParameter("form", true, false, "week", ctx.QueryParams(), &params.Week)
+	err = runtime.BindQueryParameter("form", true, false, "fromBlock", ctx.QueryParams(), &params.FromBlock)
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter week: %s", err))
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter fromBlock: %s", err))
+	}
+
+	// ------------- Optional query parameter "toBlock" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "toBlock", ctx.QueryParams(), &params.ToBlock)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter toBlock: %s", err))
 	}
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.GetValidator(ctx, address, params)
+	err = w.Handler.GetStakingHistory(ctx, params)
+	return err
+}
+
+// GetDailyStats converts echo context to params.
+func (w *ServerInterfaceWrapper) GetDailyStats(ctx echo.Context) error {
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetDailyStatsParams
+	// ------------- Optional query parameter "fromTimestamp" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "fromTimestamp", ctx.QueryParams(), &params.FromTimestamp)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter fromTimestamp: %s", err))
+	}
+
+	// ------------- Optional query parameter "toTimestamp" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "toTimestamp", ctx.QueryParams(), &params.ToTimestamp)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter toTimestamp: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetDailyStats(ctx, params)
+	return err
+}
+
+// GetDecentralizationStats converts echo context to params.
+func (w *ServerInterfaceWrapper) GetDecentralizationStats(ctx echo.Context) error {
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetDecentralizationStatsParams
+	// ------------- Optional query parameter "week" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "week", ctx.QueryParams(), &params.Week)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter week: %s", err))
+	}
+
+	// ------------- Optional query parameter "top" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "top", ctx.QueryParams(), &params.Top)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter top: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetDecentralizationStats(ctx, params)
+	return err
+}
+
+// GetInclusionStats converts echo context to params.
+func (w *ServerInterfaceWrapper) GetInclusionStats(ctx echo.Context) error {
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetInclusionStatsParams
+	// ------------- Optional query parameter "fromBlock" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "fromBlock", ctx.QueryParams(), &params.FromBlock)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter fromBlock: %s", err))
+	}
+
+	// ------------- Optional query parameter "toBlock" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "toBlock", ctx.QueryParams(), &params.ToBlock)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter toBlock: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetInclusionStats(ctx, params)
+	return err
+}
+
+// GetDailyInclusionStats converts echo context to params.
+func (w *ServerInterfaceWrapper) GetDailyInclusionStats(ctx echo.Context) error {
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetDailyInclusionStatsParams
+	// ------------- Optional query parameter "fromTimestamp" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "fromTimestamp", ctx.QueryParams(), &params.FromTimestamp)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter fromTimestamp: %s", err))
+	}
+
+	// ------------- Optional query parameter "toTimestamp" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "toTimestamp", ctx.QueryParams(), &params.ToTimestamp)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter toTimestamp: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetDailyInclusionStats(ctx, params)
+	return err
+}
+
+// GetMonthlyStats converts echo context to params.
+func (w *ServerInterfaceWrapper) GetMonthlyStats(ctx echo.Context) error {
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetMonthlyStatsParams
+	// ------------- Optional query parameter "fromTimestamp" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "fromTimestamp", ctx.QueryParams(), &params.FromTimestamp)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter fromTimestamp: %s", err))
+	}
+
+	// ------------- Optional query parameter "toTimestamp" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "toTimestamp", ctx.QueryParams(), &params.ToTimestamp)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter toTimestamp: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetMonthlyStats(ctx, params)
+	return err
+}
+
+// GetSupply converts echo context to params.
+func (w *ServerInterfaceWrapper) GetSupply(ctx echo.Context) error {
+	var err error
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetSupply(ctx)
+	return err
+}
+
+// GetSupplyHistory converts echo context to params.
+func (w *ServerInterfaceWrapper) GetSupplyHistory(ctx echo.Context) error {
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetSupplyHistoryParams
+	// ------------- Optional query parameter "fromBlock" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "fromBlock", ctx.QueryParams(), &params.FromBlock)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter fromBlock: %s", err))
+	}
+
+	// ------------- Optional query parameter "toBlock" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "toBlock", ctx.QueryParams(), &params.ToBlock)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter toBlock: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetSupplyHistory(ctx, params)
+	return err
+}
+
+// GetTotalSupply converts echo context to params.
+func (w *ServerInterfaceWrapper) GetTotalSupply(ctx echo.Context) error {
+	var err error
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetTotalSupply(ctx)
+	return err
+}
+
+// GetTotalYield converts echo context to params.
+func (w *ServerInterfaceWrapper) GetTotalYield(ctx echo.Context) error {
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetTotalYieldParams
+	// ------------- Optional query parameter "blockNumber" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "blockNumber", ctx.QueryParams(), &params.BlockNumber)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter blockNumber: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetTotalYield(ctx, params)
+	return err
+}
+
+// GetTransaction converts echo context to params.
+func (w *ServerInterfaceWrapper) GetTransaction(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "hash" -------------
+	var hash HashParam
+
+	err = runtime.BindStyledParameterWithOptions("simple", "hash", ctx.Param("hash"), &hash, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter hash: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetTransaction(ctx, hash)
+	return err
+}
+
+// GetInternalTransactions converts echo context to params.
+func (w *ServerInterfaceWrapper) GetInternalTransactions(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "hash" -------------
+	var hash HashParam
+
+	err = runtime.BindStyledParameterWithOptions("simple", "hash", ctx.Param("hash"), &hash, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter hash: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetInternalTransactions(ctx, hash)
+	return err
+}
+
+// GetTransactionLogs converts echo context to params.
+func (w *ServerInterfaceWrapper) GetTransactionLogs(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "hash" -------------
+	var hash HashParam
+
+	err = runtime.BindStyledParameterWithOptions("simple", "hash", ctx.Param("hash"), &hash, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter hash: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetTransactionLogs(ctx, hash)
+	return err
+}
+
+// GetTransactionTrace converts echo context to params.
+func (w *ServerInterfaceWrapper) GetTransactionTrace(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "hash" -------------
+	var hash HashParam
+
+	err = runtime.BindStyledParameterWithOptions("simple", "hash", ctx.Param("hash"), &hash, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter hash: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetTransactionTrace(ctx, hash)
+	return err
+}
+
+// GetValidators converts echo context to params.
+func (w *ServerInterfaceWrapper) GetValidators(ctx echo.Context) error {
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetValidatorsParams
+	// ------------- Optional query parameter "week" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "week", ctx.QueryParams(), &params.Week)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter week: %s", err))
+	}
+
+	// ------------- Required query parameter "pagination" -------------
+
+	err = runtime.BindQueryParameter("form", true, true, "pagination", ctx.QueryParams(), &params.Pagination)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter pagination: %s", err))
+	}
+
+	// ------------- Optional query parameter "sortBy" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "sortBy", ctx.QueryParams(), &params.SortBy)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter sortBy: %s", err))
+	}
+
+	// ------------- Optional query parameter "order" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "order", ctx.QueryParams(), &params.Order)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter order: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetValidators(ctx, params)
+	return err
+}
+
+// GetEligibleValidators converts echo context to params.
+func (w *ServerInterfaceWrapper) GetEligibleValidators(ctx echo.Context) error {
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetEligibleValidatorsParams
+	// ------------- Optional query parameter "blockNumber" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "blockNumber", ctx.QueryParams(), &params.BlockNumber)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter blockNumber: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetEligibleValidators(ctx, params)
+	return err
+}
+
+// GetValidatorSet converts echo context to params.
+func (w *ServerInterfaceWrapper) GetValidatorSet(ctx echo.Context) error {
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetValidatorSetParams
+	// ------------- Optional query parameter "blockNumber" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "blockNumber", ctx.QueryParams(), &params.BlockNumber)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter blockNumber: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetValidatorSet(ctx, params)
+	return err
+}
+
+// GetValidatorsTotal converts echo context to params.
+func (w *ServerInterfaceWrapper) GetValidatorsTotal(ctx echo.Context) error {
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetValidatorsTotalParams
+	// ------------- Optional query parameter "week" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "week", ctx.QueryParams(), &params.Week)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter week: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetValidatorsTotal(ctx, params)
+	return err
+}
+
+// GetValidator converts echo context to params.
+func (w *ServerInterfaceWrapper) GetValidator(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "address" -------------
+	var address AddressParam
+
+	err = runtime.BindStyledParameterWithOptions("simple", "address", ctx.Param("address"), &address, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter address: %s", err))
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetValidatorParams
+	// ------------- Optional query parameter "week" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "week", ctx.QueryParams(), &params.Week)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter week: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetValidator(ctx, address, params)
+	return err
+}
+
+// GetValidatorDagStats converts echo context to params.
+func (w *ServerInterfaceWrapper) GetValidatorDagStats(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "address" -------------
+	var address AddressParam
+
+	err = runtime.BindStyledParameterWithOptions("simple", "address", ctx.Param("address"), &address, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter address: %s", err))
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetValidatorDagStatsParams
+	// ------------- Optional query parameter "fromTimestamp" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "fromTimestamp", ctx.QueryParams(), &params.FromTimestamp)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter fromTimestamp: %s", err))
+	}
+
+	// ------------- Optional query parameter "toTimestamp" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "toTimestamp", ctx.QueryParams(), &params.ToTimestamp)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter toTimestamp: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetValidatorDagStats(ctx, address, params)
+	return err
+}
+
+// GetValidatorDelegators converts echo context to params.
+func (w *ServerInterfaceWrapper) GetValidatorDelegators(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "address" -------------
+	var address AddressParam
+
+	err = runtime.BindStyledParameterWithOptions("simple", "address", ctx.Param("address"), &address, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter address: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetValidatorDelegators(ctx, address)
+	return err
+}
+
+// GetValidatorEligibilityHistory converts echo context to params.
+func (w *ServerInterfaceWrapper) GetValidatorEligibilityHistory(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "address" -------------
+	var address AddressParam
+
+	err = runtime.BindStyledParameterWithOptions("simple", "address", ctx.Param("address"), &address, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter address: %s", err))
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetValidatorEligibilityHistoryParams
+	// ------------- Optional query parameter "fromBlock" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "fromBlock", ctx.QueryParams(), &params.FromBlock)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter fromBlock: %s", err))
+	}
+
+	// ------------- Optional query parameter "toBlock" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "toBlock", ctx.QueryParams(), &params.ToBlock)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter toBlock: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetValidatorEligibilityHistory(ctx, address, params)
+	return err
+}
+
+// GetValidatorEvents converts echo context to params.
+func (w *ServerInterfaceWrapper) GetValidatorEvents(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "address" -------------
+	var address AddressParam
+
+	err = runtime.BindStyledParameterWithOptions("simple", "address", ctx.Param("address"), &address, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter address: %s", err))
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetValidatorEventsParams
+	// ------------- Required query parameter "pagination" -------------
+
+	err = runtime.BindQueryParameter("form", true, true, "pagination", ctx.QueryParams(), &params.Pagination)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter pagination: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetValidatorEvents(ctx, address, params)
+	return err
+}
+
+// GetValidatorHistory converts echo context to params.
+func (w *ServerInterfaceWrapper) GetValidatorHistory(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "address" -------------
+	var address AddressParam
+
+	err = runtime.BindStyledParameterWithOptions("simple", "address", ctx.Param("address"), &address, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter address: %s", err))
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetValidatorHistoryParams
+	// ------------- Optional query parameter "fromWeek" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "fromWeek", ctx.QueryParams(), &params.FromWeek)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter fromWeek: %s", err))
+	}
+
+	// ------------- Optional query parameter "toWeek" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "toWeek", ctx.QueryParams(), &params.ToWeek)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter toWeek: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetValidatorHistory(ctx, address, params)
+	return err
+}
+
+// GetValidatorProfile converts echo context to params.
+func (w *ServerInterfaceWrapper) GetValidatorProfile(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "address" -------------
+	var address AddressParam
+
+	err = runtime.BindStyledParameterWithOptions("simple", "address", ctx.Param("address"), &address, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter address: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetValidatorProfile(ctx, address)
+	return err
+}
+
+// GetValidatorRewards converts echo context to params.
+func (w *ServerInterfaceWrapper) GetValidatorRewards(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "address" -------------
+	var address AddressParam
+
+	err = runtime.BindStyledParameterWithOptions("simple", "address", ctx.Param("address"), &address, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter address: %s", err))
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetValidatorRewardsParams
+	// ------------- Optional query parameter "fromBlock" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "fromBlock", ctx.QueryParams(), &params.FromBlock)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter fromBlock: %s", err))
+	}
+
+	// ------------- Optional query parameter "toBlock" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "toBlock", ctx.QueryParams(), &params.ToBlock)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter toBlock: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetValidatorRewards(ctx, address, params)
+	return err
+}
+
+// GetValidatorStakeHistory converts echo context to params.
+func (w *ServerInterfaceWrapper) GetValidatorStakeHistory(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "address" -------------
+	var address AddressParam
+
+	err = runtime.BindStyledParameterWithOptions("simple", "address", ctx.Param("address"), &address, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter address: %s", err))
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetValidatorStakeHistoryParams
+	// ------------- Optional query parameter "fromBlock" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "fromBlock", ctx.QueryParams(), &params.FromBlock)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter fromBlock: %s", err))
+	}
+
+	// ------------- Optional query parameter "toBlock" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "toBlock", ctx.QueryParams(), &params.ToBlock)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter toBlock: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetValidatorStakeHistory(ctx, address, params)
+	return err
+}
+
+// GetValidatorVotes converts echo context to params.
+func (w *ServerInterfaceWrapper) GetValidatorVotes(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "address" -------------
+	var address AddressParam
+
+	err = runtime.BindStyledParameterWithOptions("simple", "address", ctx.Param("address"), &address, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter address: %s", err))
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetValidatorVotesParams
+	// ------------- Optional query parameter "window" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "window", ctx.QueryParams(), &params.Window)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter window: %s", err))
+	}
+
+	// ------------- Optional query parameter "fromTimestamp" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "fromTimestamp", ctx.QueryParams(), &params.FromTimestamp)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter fromTimestamp: %s", err))
+	}
+
+	// ------------- Optional query parameter "toTimestamp" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "toTimestamp", ctx.QueryParams(), &params.ToTimestamp)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter toTimestamp: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetValidatorVotes(ctx, address, params)
 	return err
 }
 
@@ -423,74 +1374,221 @@ func RegisterHandlersWithBaseURL(router EchoRouter, si ServerInterface, baseURL
 	}
 
 	router.GET(baseURL+"/address/:address/dags", wrapper.GetAddressDags)
+	router.GET(baseURL+"/address/:address/delegations", wrapper.GetAddressDelegations)
+	router.GET(baseURL+"/address/:address/delegations/history", wrapper.GetAddressDelegationsHistory)
+	router.GET(baseURL+"/address/:address/methods", wrapper.GetAddressMethods)
 	router.GET(baseURL+"/address/:address/pbfts", wrapper.GetAddressPbfts)
 	router.GET(baseURL+"/address/:address/stats", wrapper.GetAddressStats)
 	router.GET(baseURL+"/address/:address/transactions", wrapper.GetAddressTransactions)
+	router.GET(baseURL+"/address/:address/undelegations", wrapper.GetAddressUndelegations)
 	router.GET(baseURL+"/address/:address/yield", wrapper.GetAddressYield)
 	router.GET(baseURL+"/address/:address/yieldForInterval", wrapper.GetAddressYieldForInterval)
 	router.GET(baseURL+"/chainStats", wrapper.GetChainStats)
+	router.GET(baseURL+"/chainStats/history", wrapper.GetChainStatsHistory)
+	router.GET(baseURL+"/circulatingSupply", wrapper.GetCirculatingSupply)
+	router.GET(baseURL+"/contracts/:address/abi", wrapper.GetContractAbi)
+	router.PUT(baseURL+"/contracts/:address/abi", wrapper.PutContractAbi)
+	router.GET(baseURL+"/gas", wrapper.GetGasStats)
+	router.GET(baseURL+"/gas/history", wrapper.GetGasStatsHistory)
 	router.GET(baseURL+"/holders", wrapper.GetHolders)
+	router.GET(baseURL+"/logs", wrapper.GetLogs)
+	router.GET(baseURL+"/period/:period/dag", wrapper.GetPeriodDag)
+	router.GET(baseURL+"/period/:period/rewards", wrapper.GetPeriodRewards)
+	router.POST(baseURL+"/signatures", wrapper.PostSignatures)
+	router.GET(baseURL+"/signatures/:hash", wrapper.GetSignatures)
+	router.GET(baseURL+"/staking/history", wrapper.GetStakingHistory)
+	router.GET(baseURL+"/stats/daily", wrapper.GetDailyStats)
+	router.GET(baseURL+"/stats/decentralization", wrapper.GetDecentralizationStats)
+	router.GET(baseURL+"/stats/inclusion", wrapper.GetInclusionStats)
+	router.GET(baseURL+"/stats/inclusion/daily", wrapper.GetDailyInclusionStats)
+	router.GET(baseURL+"/stats/monthly", wrapper.GetMonthlyStats)
+	router.GET(baseURL+"/supply", wrapper.GetSupply)
+	router.GET(baseURL+"/supply/history", wrapper.GetSupplyHistory)
 	router.GET(baseURL+"/totalSupply", wrapper.GetTotalSupply)
 	router.GET(baseURL+"/totalYield", wrapper.GetTotalYield)
 	router.GET(baseURL+"/transaction/:hash", wrapper.GetTransaction)
 	router.GET(baseURL+"/transaction/:hash/internal_transactions", wrapper.GetInternalTransactions)
 	router.GET(baseURL+"/transaction/:hash/logs", wrapper.GetTransactionLogs)
+	router.GET(baseURL+"/transaction/:hash/trace", wrapper.GetTransactionTrace)
 	router.GET(baseURL+"/validators", wrapper.GetValidators)
+	router.GET(baseURL+"/validators/eligible", wrapper.GetEligibleValidators)
+	router.GET(baseURL+"/validators/set", wrapper.GetValidatorSet)
 	router.GET(baseURL+"/validators/total", wrapper.GetValidatorsTotal)
 	router.GET(baseURL+"/validators/:address", wrapper.GetValidator)
+	router.GET(baseURL+"/validators/:address/dags/stats", wrapper.GetValidatorDagStats)
+	router.GET(baseURL+"/validators/:address/delegators", wrapper.GetValidatorDelegators)
+	router.GET(baseURL+"/validators/:address/eligibility/history", wrapper.GetValidatorEligibilityHistory)
+	router.GET(baseURL+"/validators/:address/events", wrapper.GetValidatorEvents)
+	router.GET(baseURL+"/validators/:address/history", wrapper.GetValidatorHistory)
+	router.GET(baseURL+"/validators/:address/profile", wrapper.GetValidatorProfile)
+	router.GET(baseURL+"/validators/:address/rewards", wrapper.GetValidatorRewards)
+	router.GET(baseURL+"/validators/:address/stake/history", wrapper.GetValidatorStakeHistory)
+	router.GET(baseURL+"/validators/:address/votes", wrapper.GetValidatorVotes)
 
 }
 
 // Base64 encoded, gzipped, json marshaled Swagger object
 var swaggerSpec = []string{
 
-	"H4sIAAAAAAAC/+xbbW/btvb/KoT+/xctoMRO2nWrX92sWbdebF3RencYeoOBlo5lrhSpkZQbI/B3v+CD",
-	"JEqmbMlximxYXsUSeXh4zu88krqLEp4XnAFTMprdRQUWOAcFwvzCaSpAynf6of6dgkwEKRThLJpFV/Yt",
-	"UhwtCVUg0GLzXxbFEdFvC6xWURwxnEM0qyhFcSTgz5IISKOZEiXEkUxWkGNN/f8FLKNZ9H+ThqWJfSsn",
-	"bq3XZp1ou42jBeXJp7dl3sPct/o1elvmCxANU3+WIDYNVxWNBYhoKCe/EKZePDcsrLBc9Sz/A5YrxJdI",
-	"rQARBfkTJTCTONGvn2qJZaBQihVGSy76hKbpHy0xzYHhssAZYVgv3MPru3pAr6AaGkfz06ziKfEzwKce",
-	"rn4F+NQDrQ5zmshg9Wmy0Vav7Z7oCVdJwkumjAEIXoBQBHwDGIjOSMMSU8wS0DPgFucF1RxOozhSm0L/",
-	"K5UgLDObb+T40bOPisBNPYUv/oBEaeJXDTse8dvpwL9dLmqSTicnJPwtyd5YkS65yLHS5kayc/0sMPoV",
-	"pvQaK7yrAqtkn68UKGRYwRMntKchgsaRGQLa/Mw/O2PcAywE1nC6Pcv4WfWMbXZ0ZDipKe/oRxPguCBn",
-	"CU8hA3YGt0rgM4Uzs7qgRTSLGKGG7qsVJuyDws7rtnZsvNIbpkCsMW1t/WI6rVdl1m1t4whYarzdUM8V",
-	"R1JhoUbOUYU8yEtHYN4yHpeWVNzZZgjur7RRvgdZcCZhV06KKyufge7aZ83ODS16jbPdpYwnHuRw44jC",
-	"GugIuZIcpMJ5MWJKE0teVX7rCBm48GL5DVD1eeuRlHReHVJfTZjSn5fR7OOggOBN3d7EHbmnziXUNjyM",
-	"tNbh9qZr4cbrf7cGpn7k2Sl8fcXdjmOhPHvDUrgdrtLKyz2IH9Nqz/laa72eveCcAmZmOi9IMmaJNgR/",
-	"GGEb3rRRAuogt5Zv7AVOo42446Xr3TUy2GU+wFcI79VGj4mQYyLnD5ymIB6paVWpUo95GX/OMJ03ApX9",
-	"HvxIHjziQT7aYDGL6FE/m9QSUweqVjB78fWLF99cfj39Km7SldKOiyNWUooXepxNeN1yhCnITOUwIvAH",
-	"tdqWCrB0uONYYfkWbpXNn5e4pKrDpWfoJh6PCDP3jq/Vkib6Rw2zIevaqQ925EJJTtobfTYNqSvHtyQv",
-	"c5ei5IS5XzuK80VS05wegYAd/2Q4De5ysQyVGaVacTEi8ozJR1xy9hdISJwY4iozYVVlPjo10WJ+pA7U",
-	"IKDHe74DQXga9AjXWMEDeoUx5ENZvpkf14zuN3VT9+yLCpkcBaM4oliqa5zNh0K3EwocBa2ae5LwQtM9",
-	"KBWLpRopAc9ExgpvjSlJseLiPWREKhAwrJ7s8t3BRbOJ2FNpiNOQ9AM63SPhPZsIAdBPIGZ3EXcb0Vwn",
-	"mFKbMcShsvztSGdakzswo+5/bONoKXg+IhZkWL7i8qC+XT9mZPAgrChVsCKQCquy3RLo8zDHRBQ+QgJ2",
-	"Re0mdZz/OI0v4sv4Wfw8/uqmE8y/iYLZm554tsZC1w7SpC8aIEvzNuFMCZyo37UqW78FVB1R4rLe3715",
-	"9bMugcCLitKNtcYShiozXNG329oGTkaiFfEGM75uapU6EVXKP2BAP/Ls9Bl+XaWPSO/9kuNxhv5Ddcs2",
-	"jkK1yeWzUEq6m8v+p3KBp+htHBGCBGYj+onC+GlhgH9UuImjDQGadiry+OLly5c7VfWBEu0PaY4cDL2Y",
-	"51qthdpEsavdzqxufNSZvfrdBz/aWb5CWwzZUq22R4raBlYBzNojnCGHLg1jWpa/ulltDitadc564SGf",
-	"MPXsMmoXdIfqsjjaABYtkpfTlj3tUL2cXl4OKvh2FNna5WDx2gOp+IB6bWGwDeHnN422fg+s3f/YVj8f",
-	"OWG4IXbtqDKVhstm+RuDFMKWJhfQ0RInxh1BjgmNZtWjfyks8C0+J7w5E5ybR2gOWMe+UujhK6UKOZtM",
-	"muHbuHPoOF8BclNNHxAEkngNEmFK0btvX8+RYU3G6Prqe/c/wixFfvBBnJlzX0coWWHCzCC4LbjUxBi6",
-	"evfGDOOFPSXG7jTY/JdghhaASglph9Z3twXlwoR1ShJwCnd7/unNfGevOVFnbuQ5F9nEpmOKejJyG9XZ",
-	"AQhp5XBxPj2f6rG8AIYLEs2iZ+ZRbI6oDbAmzvlN7tw/20nq/GkGavdA9z2oUjArSi29hZWeBKbQYmN2",
-	"KYFCoiBFjqI58tVgNi70TRrNou9BuSh1rReLWzcWekytGTJp3WjoszpvfPfwXFugcLZmtno5nVboBBss",
-	"cVFQkpg5ExtY7ryz6cEHGDLk0o1FdG5hoH9/+Pktsv4AGZMgjLAMYUSJVBpeWuLuLkJX8Nru+kRvlnJe",
-	"s6vMXxjcFnYCCGGuMJgz9TLPsdj0altbt8HIx/os+0bPC2BJB9RhYDJ26TZVCJ6WCaRHIcp0jf6ukOpp",
-	"iZ0AUyPlfzJYeeuOwJWsDt334sr0r5FtQOp9emvFvhHt+P5aAEsuxiPQ3gi4HwLvC6d9IGp37sYgR4ti",
-	"j1AHye0kyDH6r1dpEvih+PHbV4Pckz/B+lsNGsXHo8MH2t/VTe2v4k/grdrqeGCwdfU/Amd1Tr0XYGZU",
-	"F80IKyQLSMiSaE+sDWwvrn5zSfjDAqp9YfMLwaldHAXh86oUQqdC1jtZgWLtJ4TBkESmaYYSXtJUp+WF",
-	"AKU2aEGyU6EkqMSxUHnNhX9n7Auhxl/13gBqM/tau0rDhgsZvRdA/eJx7CXe7qpzPmzNqkg99jpszcE/",
-	"dnCkHSStK5R78W5L8Hbkp1gqdDGdugTkyfzdh9ipnjhEPw3j37u7+YCplrdKQFkpJCTHFH0maoUuUfWz",
-	"oDiBk0UvT2yeFjzGrCJW9r7S4ZxaS90F5PnV+yuk+CdgyE23WdEKiEDuCnRPMuSuR412NydPaDpXRBsp",
-	"jGyw9t73Cp4R9BruKNFqe8bOts8qGy8wEbWhy4e09NFQ8PBX6d+Cz3irD2VR0M3Aok6awWFszT1qJ0VH",
-	"YnXUMNp0Si8unz3/6sXX37wMfSVw0E/b3XxhR+0v7WnGl56nnd8GZbJScQFpK/q4fVAuQer9dtMTP0aH",
-	"VXlcZvtPprpf8VXTvtK7p2Wn9qbkmdytsFxtBwWHFBKeagw0022Aa/SuifXo2ztcHavw5kOuL1/lDqlp",
-	"7buFrWkPSAcRltAyNWMprWUKa83AE7Ksnjw9R5Xsn0+fI7I8SHiFJWLcnFOcMg4EtO57eyxXvaiatC9e",
-	"jOnKVDPb/QC+PA58oWvPjx+Fey9r36fVcg/hngRWwfUHg4rygadYemCgrXSUz/qRZ/Iv5bda934GgMUk",
-	"sP2QgTUohmjGR0j0JFgxjrFR5SEnVN8zHAaRZvhur/EzwKcq1e3piqsVbOrWeBhDzfWR0fBpvr59vP3g",
-	"fbdj9qHOwo0vPQ2csqm79qVewcRTRRcsk/pjimOPnZoTEl2T+xg6hIu5+xbjaHA85IlS+xvPE54onTbl",
-	"DS4xUPV1o/ag+glbcoQXvFRmdzWNsO84oPcHb+h/IYR4988OocOECU+ItQBPl1iEFdSLBE0FxLrSQHvZ",
-	"nzBhDBRioD5z8Wnn8g6xN3POczvu3L+4tNO3BqmG0FJ23F5a17AeQio1w3xKN9v/BQAA///KlVlAUEQA",
-	"AA==",
+	"H4sIAAAAAAAC/+y9a3PbtrYw/Fcwet+Zk84w8iVJu+tPTxKnbZ7pxVO77fQ0mX0gEpKwTQEsANrR6fi/",
+	"P4M7QIIiKVFudnb6obEkElhYNyysG/6a5XRTUYKI4LOLv2YVZHCDBGLqEywKhji/kl/KzwXiOcOVwJTM",
+	"LmYv9a9AULDEpUAMLLbvyCybYflrBcV6ls0I3KDZhR1pls0Y+rPGDBWzC8FqlM14vkYbKEf//xlazi5m",
+	"/9+JB+lE/8pPzFzfqHlmDw/ZbFHS/PbHetMB3Cv5M/ix3iwQ80D9WSO29VDZMRaIzYZC8gsm4svnCoR8",
+	"DTG5FlDw3zAp6H0HKPpHiaYclnldQoEAl2+BJe2G7V69FYGFSL2ZXfwxOzs9VZBLdJ5JJJ8/l///qpi9",
+	"z2ZiW8nXuWCYrBSYK8gVNrroqBEA6BLoUWNIV5CDiuF8CMwOKg9zgZawLsXs4vz0NJstKdtAMbuY1RqL",
+	"2WwDP+CNXNbZ6al8YoOJ+eyWgolAK0P1NeTrjmV8B/laLkKsEcACbZ4IBgmHufz5C7mmFRKggALGS4j5",
+	"VI6/N5NKCBSUFVxhAuXEHbBeuQc6cenH2BseP0sgN4JWvXwgUVhCtkJcgAoygXNcQSIanMHXkKGd/CBo",
+	"lWaGs35e6GWFe4RuuyQOodsOtdSUMoRuB4u+HHb2IOc238gXXuY5rYmQf1aMVogJjELlOVCzzaRKgyUk",
+	"OZJvoA9wU5USwtNZSqY9Q/wR6FY7gFcDdPEvlAs5+EsPTjD4h9OB/7WhcEMa5ppw4Fd49Vaj1DHJAq/m",
+	"8rvE069hWV5CAdskWNVmxTF73LAaAazZPKdEMJgLsIYcEApevnoLICnUbxsk1rQA95ADNRIqwGILsOCA",
+	"oxLlwnC+AWhBaYmg/OLDUwor/DSnBVoh8hR9EAw+FXClQPkXVzCoATO6kZqqEpIjWVnNLmZPFXk1d4YI",
+	"LVCJVlCgJ4baX6QwoXZvNYscVv3ResZ8ARmDWwXrij6135Fti7kUJG7kFmP1LFavieBSjfva7ZdtUqmt",
+	"4y0RiN3BMlr62empm5XovfohmyFSqE1t6Hat3rjBG8QF3FTD3+ICMjFyJlHx3hU00BxME6xND5U1kNNY",
+	"SkrYPaa/w1xQtv0Z8YoSjtqIL4zkOI7ZtUY/bouVmitS4yZhMxL3coGjeWFRYCmgsLwKANT7XWuQeOpo",
+	"0JdM4CXMRd+YDW29wL2LDwBv6eAF7lvsL1VJYSEnoQT9tJxd/DFiumzws275D++D+X9Qqox3s8H43WoU",
+	"4+j5X6udso9z/H62g4dqIroXI6iAZR9IgSEfzq7fTU16CVftqZTBOMguzGYlukPlCDUyXlsFJu9ra5Xs",
+	"gQNjBWt4E6OGsHVg6k2xShBmyehmKLYEHfykmt+fjyp8RzWQVfpEFK5VgaRmM092LOhbBqt1e0WoWCE+",
+	"WAwsYhIajNBi3EA/0iI5UIUYpsWelDcvW3Ays74OnCgQHlMkKCu0pQnLcoAKtS++z5rnL8rVvmAPO2qD",
+	"BZioDxoHYIkJLPH/Qv2cnPgdUfhV3DVwfRwRA/FArbqP0ONqOOPEMuO5ZlrNYZYdqBC3rKQ60XTNQsnt",
+	"ZLorRotavd1hSsI7xOAKXeLlEud1KbaxMfbl/EVw+CxovSjRLGFhFnDFR6FCYuIesgIuSnQ5/mVlAk7A",
+	"284ytMwtz7hAja4ZOMC/h/HASQPfQTA6wCQv6wIV8jB++fJb62AygN3BEhdQUGaew2QFiroqcQ4F4hrY",
+	"mmikomI0SlMm9iyka5pg6TlTaMsSrDaMaScyx9sD72+Wy6UahxEqQviGcUb7VckkPWsbNrS0u5Qx21iY",
+	"BBrliAjm9PSuw+VwSSzM6XUnUFjaE4tazvoDEgznCvfVYin2fJULeIv2fFeKeOQukT61Z+eztuMsm20R",
+	"ZMOebbCOejGzLrOFOaFqsM3KDe6S/KUdGEpdtDT2ZohcG6+QJJAei47ZWEvIxes1JCs08jTvtNTgyZoi",
+	"56ANB8vsqtug7UbfmzuUdDeOxGEY+xguGXsgfh+Lhv4aIn0St2Lg6nrKb3H1lFbaM/C0opLjmfULDPHe",
+	"BQAGPryHeHP9boQd7I4wbqmG4KiwHr/M/ivl9fzFl0kP4MHMqkbM+nk2Dp11mHdqdQO4Ob3xHLIxxuOn",
+	"DF5EiuEMuYb8R/RBBA5V6+0NDbcDjBIJjZ9mh+fDr4xPjqvkuWCIK8VqlaQrZddiErtZ24mPCY5E43T+",
+	"wo3lzXUCb+GGCvqaImmMYTTG9g6DXGPUVHUtY2ADoDsEjRFwmcNqasGZxlYAWgrtb0q8wgtcYrHV287B",
+	"FhNSI5aoU0Bu0dDF77FhNPBlLZNQKVkrxQHag5dp3eZthO9tpr8xC4g2yINduZgLG3kcRiOLx2s84LXo",
+	"jDucEzrdwpaYDuwmPIMQt0N/jj4vjOaFkIAprbtmiK9pWeyJKycCbpwdWlhtkd/T1ZRBgZZhcmAoFkkY",
+	"U5FYQSucHysMW9LVW1KgD8NZwQZujxKalTTe0DtUpNWsQsWYKQ4wV/1roxDUYFOH36wZ9MkagWe3Oo+D",
+	"NvAJuFL8/i3ku/wEY/12+8WVl5CPmEMGKUYeW1eQ/8LRCBt3A0fw+QYVGJIRz+MRD1dnpyMePn8x4uGv",
+	"xjz89QgwOFyi7+n9qK2QFJAVY8zNkSyQdPTuIaae+zwQDb7PItlJO0stR2pu0GTW9HP8pCmkUa850mM2",
+	"QJkRn13CPa0BZ0fd326z2nUfX8aY1KnvaFkg9pF6cm2uXIc3962MAvDewM73UCCSx1Gd50ODOi68cKyg",
+	"B8B2FTL8Ecc94FIgpuyZJWZcAEqQjnJs4IdgVYNV6uh3pooxXekQKTEIYEA0g04F3PbGnA7wl+wIxlik",
+	"RBiKUByxQUpYY0acSIfEg+6vSZRhg4rQah9GTffGQ9ZcyF7O4EnO6QkXYnvR79WyBWIEljcB5UdQZhiK",
+	"gsGTSipFoods9j1dTe7CbJL503FhhrlgLRzl44xvnaI7OlW2sQQzSmZmTwH9IxL3lN26namxE+hfgWSd",
+	"Oyy2NpVEF3HMwU+k3Mqj6lOVDxHHyCGTR9+aCFRkABsujx/ZwALJA689IauXyH8JF19XB+DGZilBQdcq",
+	"EWPaza4m+M8aAYNdxIFYQwG4PKCHUGvFv0cWxRIhPtwTRdD9SwvJpMtsrG9FhSQpZmbvDpY6B2+TZJOU",
+	"tRTKAKeOfk9zhqSqCOaQjg6GclxhCaecP2aFJWIRq2jsyqDrR5Nmosucptj0VcypHmGzWnZoQfmrHMdh",
+	"kGm/Ea/zHHG+rMsWw44wN8I8Ek8Hw75ZQ/4afGoX2KdpJtpLwiH3Nzx+MgFTQ6U4qeqrL7/8x/lXpy9S",
+	"dT2kLkuoogNRNrfNMxhVQzBgm913W3TVSRGU++6S2QRZyFnH5pqiTqvYq4WXEm9wvNBnvWVYu6uwApS4",
+	"MU/34ICWX1BBmlylya9p7HW1WI/KSRiTokqObRZPlnxp0JDZLEzibOuxCdwSzR+pD0FxQIcD4cqlPrc0",
+	"wiUU6IhaYczwqT1GvZ85QHtEXS3zZ5Wj2OXF/gaNiOaNSxk3mk3PP8EefSNHAxupDKQNJEfV9oNLPBme",
+	"3uyiahY7fbudS3gP15R5HEZQpIhxjVcEipo1kvf9ccQaH0+G5O80j3jXAt5isprWm2kGvSaw4msq9rcI",
+	"mgMdGkjleryf5TbWPmSphAZrbSpyAV5XVbnVBjrmwJ78JAXjhIwBrsG98tQELF2i1nB5U+9dK9gPDfCG",
+	"iVfBqC3YGsjtoOZui3P0Sa6EXFzC1eDwWMPCNCNIjX/gEIFf54CR9jhtHXYEsqpshblADA2reG3C3VR4",
+	"wVGl6Elvb2M/QdMdGN6xiCQDOok4SIvkmKkOBVIzjZKxbFZUlNuSyVe+Gn/YyytEEMd87JxycaiIfBiD",
+	"tLgLprR3DT3kWEBUWs3otybUZZH+iqBJU6aJ8sbCU5zQRnc3I0686aoxJ9hz43EOFRZtdh0xOe9xdjuz",
+	"jBTCbhjMkewR0cZVDstyuMD5gRIiN6SyNTiAriA/YioHJlUtkvk/3l/esK2sFWvtK4kaVNgWGHgJsLKw",
+	"bgm9J7NsnLc9m9FadEEk6Ai02TT5GHhJEyB/ygCar+YK9swZg/oTF1DgXP4tw5Xa/xqtQ/7UkVFf75uy",
+	"aJLpwxJjPZxmgDAlQpPMYSozvNnB0C5IdfHXzNYxyAnlSyZ/yvqaX7rEKheYDuKgcOXCkjq36g4x8TOC",
+	"nEoqG25pHd33Chc62HrecO1cHtqLGJOIGK5sVKLVODF+TfmIqpsV5FcMj0mZHS38Y3xbLZb4G1SMS/s0",
+	"uqZAOZUloyqVokAfdPHOSIVD6Ki85IjvW5Dr7y3YS4gl2GHKhwVZcg94wxhlTzQoX2TgChKcP7FnfqV8",
+	"ai7oBiD5nMOFQUK00reE1za1Hyyc0dNaLBdQ1LHXoctZtdf2PUZB75kY2mricJqdZefZs+x59uJ9w6P8",
+	"j1kyhCBffHoHGYEbxJX2NY6XQBn+02h5/1luBLrHmo23/TN4z33XHCDxgx3p/YGbhnHextkRHVuIUj6t",
+	"SgfJDJndfOzGoiUi0EDh7jMobzbYd2TCw+TJFz7VYXjmRQDTR+qv7kspechmqYDa+bNUHKUdgPmFFH9z",
+	"YS0uJogmvy2sJqyDBc3BfyNGZYvB6GsOJC8gLv3FC7SkMsxecHCP5B9EqIp4GyE3T47MnDVv3RwUyhmT",
+	"UF8TCaCD8kBsqnF0JF72VFDb0v0a5+sIjSCHBCzU3rPEbIMKlYSwrInEJRbrgsF70vDEj+ALOwTsqAsb",
+	"WCWNi7DsNCJngk4xJhtADKtNDSVqqgB8OOajV1lOWyoWeoF7FdSg0L6utdnDucogGSXT0gPJFA32cqR2",
+	"9neZEg3MR/W8IXg2sL594ByuCu9oM9xRgX5DeLWeHD9bjMpGvt9pdvb111+PBdcUgKnxOgrAGoKm2C2s",
+	"VApd6RquFJcN6TgT4MuXN1pW2CnTHd0g9juhq9rUVHoj3CDXtadidIlLmcKOyoID/ZLKq3KFerrdbjsU",
+	"mtPNBnOu7e3e2Gfw9MVf45NKhtHfT5JgApt0FGHjr66JR/FdMOSOiREpVHeKqWa14+2Ykt4TzTNHbJ+h",
+	"5mg2zng0W6vda+O1Y4JrJKI4/dlx22yEho6VvkN7a8SqYfK89Hj4Tygt3S1s2riPG1Z2Dz8wA9ONdaV1",
+	"8BQGXaxlDzx4eEGSGfDrmhQMFWKt9g4IKsRyvTu0dWpLxELV1/pRdUxyc+3V1kkO8UtVQDH2RacgByJ4",
+	"tN2ZNllvDqy28WaLXkAW78YhOQLkpw2aNFy7yNLG907+7s5to6TmE+Sc6ROyTpgEalDlZfB9CKVFFpQw",
+	"TDClXpOaxphRyk0R1Afq6Wz5w6FZdYF/Wg6pk6QgU4UbuiNj3IPRswOoKC3fkdEH0/38BArT0+M3R0x4",
+	"KjaEIdx3FXktGJnhMJfG390Ousms3fvFHtX7Oi3xCO05i6Dlkul0yxuMICtdzNkDYGLdaE4gR6dfjitb",
+	"H1R7HqFn4EnpGh2eLaGTDsa1Mzoki1VP1WcvxAk05vTYk7bamGICQ2LK3j79EE/UuKpApYBDgf6oOllp",
+	"yPsRdSRrNiTC4fast41b4B3dL8htC7eObOOrV9/c2AJ+t2kbb0fYwdj1Wn5H4vTj0ziY05GBPMIHpuT8",
+	"ajxejtMldqxPbjIvQtN197C7Ya1x34VOuwYiLS/sZNWPNMwYtBN7nziTW9L3XW/lAZPI/JUK1CGWtuGa",
+	"rkWZtvI2bZbI5l92Vmm9SoPN2SfSckVFAMykoQnbgFFC+rOp9AkE/Ot/TCrej1ihG/vpD5zvut60O7t7",
+	"Mxzcq4ni0ngPRki8Q9w9Db5sDN7klBRxI7QkdYEVi6k2VTve/jvpb0a+Yyis1Pt79wJGtfo8LPDsq9P0",
+	"+0Jwr+P5s12jnp+enw8qAE2uabyOlW/13pekya94uDXt73JbmfQwN7px1/ANtbXlmTBU6tj0Xj7MUV4z",
+	"LLbXclK9IljhG3qLlBtOwaK8sAgyxPx8ayEqvSlgslQZYTklwlyzhTYQl7ML+9X/KSAutznbVoLOCRL+",
+	"usVL+QO4QXAzy2Y1K83A/OLkpPnOQ0udrRHQ7+vWKAxweIc4kCm9ykZTq+SZcqjov1VCQ5giBKhWPnoc",
+	"dX+regZ9qKjuwgBeXr1VT1GjNqG5NFT9ZTImao6KeKg3H6qSaoSVOEeGc8yqf3h701ruBoun5sk5ZasT",
+	"fVwQpceSWaVUR4hp/+zsbH46P5WP0goRWOHZxeyZ+ipT95gqcp6Yw9TJX+aPhxPrwVohkcpuFDUjGo9B",
+	"ryrVz8OYufq6Q9+vQlm4UiiU0nxbzC5m3yKbpHup/SnhTcIdIusfOYluGn7Iep9v3rAqJZkZmVVLPT89",
+	"tUxqAqSw0j2fMCUn/+LUszscdRUFTxlxD60o4ewl+L/XP/0ItF5R+Z0QE+lZhKDEXCiPeFlqHkMtxKuU",
+	"nQ7UP2Re+zaJ+QtBHyr9gsovNb4vXm82kG07qT3LZtoS+cPdE6q0RYqXfHJML0vlNWNyNcE7dsHQXx7t",
+	"PRWAUyb06Upn/OxmtACSw/jtUOYZ1l6dx8b1LnaRaUu7sCZ1lq6AdSWvHmOT8Ef35Pvxysla+yF6ecYu",
+	"KPO5aigDDNm/1dqbaWw5KnXmQQtTcRKcUBcbC8QHc5Zxn/z7KbQR1yOkVNpeCi0giqbG9NwIDB/tz5S6",
+	"zKBfeRF3HlWlOPIPWSWwtXUKdBkraJsJPgfmsk0VbLGlAUqioy7Xsr219Lb7ftaquApw11BgJ4+aST5i",
+	"zdd19+gY3jLEapZJBBuF/LpBqqm4rlGZwg0j5NZ7NJDhpMdpmPm1y9U4ygZT/Vs+VSOsoznNBFbYSPxP",
+	"ZogF847gKy7gAL7SZoKXkGCuLOpN2zwqOQTYiOooDtT+jI9XOcX+mzGc47uPJJE6CG+TcI6iv5tl/EYY",
+	"dnwYpJ7CF7Q9pe3Q8dwRMtrjq6msvzQx1vymrrhVeWgaezr7QL6q32hhxY0ojQKmkIpUXTWvUI6X2PTw",
+	"xBKcP2ukLE7jPnDdST1nN51Aj6N4dxdbTaB/YwY7svg0OXqE5ETFSL2iEz5tK5fa52DpXrpHuq2rr8ih",
+	"zBxvUAG2SABYUrLyxqS+t1is0TZRzLP7jBOVuXzEijpdjjPsBF0hopK54uKx1FlaNXbVWn3ac/QQEEZw",
+	"nvMM7+Q49VRzZwBQeGWjOWcng/xuXMnHVc421/lRLcjYxZ9UXK+N60rzhEYo5EBrXPmXqsKVZ4FSFiGC",
+	"iiEhtmCBV1NxTpKIY1nlG8pUb+I7WD4i14SzHsxAMbDfSLNDqz1tfnVum2EIZKCqcaHF1jVVdNicPkPN",
+	"B2V0wGskBJ/lYE85UDGd60EnI/Vow4ouIRfg7PTUGPNPbq6uM0N6bDha9XCwz3vRcPHshGy89kCNFQe/",
+	"nt/UBI/ht/HQJhiiQDnewFJvsOfAfqxKmKPpPC+eNAGlA8CaxB7sXk4R3eRF5LBU3cKU7aQcmOXW58ta",
+	"7ec2bwbJCvXRe1/3cQfZ+9VhZtrT6+8UkWSYtDBLXmyBoc7foTczLV9YX21hfhoAklerhwIUJBlRTAS3",
+	"2SiGfToh0E9HAPikitPeNto9fbQfSZybqaDDjGilFs1ZLZQe74K1VsHUom+pslMFpJo9DnCImc6xcoW0",
+	"dt11nHDrLoH+foYOOW9NfiAdg4Ls82fPX3z51T++Ttzy1b1LB9iwK3zczboNQEC8m6Bln6GevdAksFvh",
+	"AvfSUMZObNNflYwhqIm0NG7gIEUjLmhnNKrRbd87vVY2kvFygf8N4i0SypRov3rbRMIcWIQ+P31urmFl",
+	"CGB3/aorpjEvTB5ZefnqbSjd5muuevtUdWKKa5Xzk4Ktg6Jz8Fb+VJYucWcgr2AP5vwdeVkyBIut27si",
+	"n1/k3LMRP7iy2UVRwVUrqKRGlDPkOaoEBwhLKqglqiRAoGuzKlwiBiATeAlz8UQ5JL+DrJBSQBm4YfVy",
+	"WaIvvM5+N4ML/G6m6/QTfH1VT83XujULLbbHYOlfqpLCopnkLQ9XD2mZanO/zCBWxtCebGxS5xR2fNLc",
+	"H+8f3oc8rgEdxONSBZpOoDvV3QrKsALOkS2hxaXfqiImttcNWbtG2Vs2wuN9h7xerXT/JDd0h+pzN1+O",
+	"5Y8V5DoJ7xE0nwMyofa+dbjTNkWUC92hAFUnKUKbqG3lLR+uCFcN6KS68MRRv4QnoG9hwDaDzzyrXSiY",
+	"8NjTuHv1YJYZcto5/Xyk+c870nRd8jv+QNMUjcahZg6uNE7tOaFbI6i9X9Y0Vag4lnZon4e8Qljri3/7",
+	"jz/B6i+//x0IuYsB83YQDLEnorSom2uGR4v45CkjcXJ+gISR9VOd9yYnezZ2nsDGYFYeyKA5nD01/4IK",
+	"YuZOavyYR7WxjBBwnaW+5rySDsguV5Y1kI8CtMEiSN7yxjMpTijzMupNeX33v5Q3yHMTUKOsQGwOXgpQ",
+	"IqjvUg5ubVR3Esu3TgFfWxzGofYWT8umpm2GbqgTO3zD8FeHUbswhVm66tS8QbXzIHUXNBFq7Yj6Lkq5",
+	"Uu+6WD3R2HaZhGAN+fqLHXuRRNRgcHQfpUQWBcopKVrA7J727OBpvwn8nYICjiDL15rTMJmDSy0oKtP8",
+	"9FEshO/hUHh8OnIQWZvaWvg4k/jSVybvm0OisLuBIl/bDK2lugTxUF15rYiHQvUVqEG5BqMDdcnqyV/6",
+	"X1Vx06sSg+S7JSawxP/rz216HPvJ/KwzSJTiCw9zqFghy06YgQrfUaH1N674/B25ir5QiSIMLRFDJEfd",
+	"ADB0h2nNDSQdZoCuo7uEq7beVGxcQbEOLE5719rfHBztqfD5lsFqPdCWlDRcyecBoYW5xlfRI3WytFS9",
+	"h5z8lz8zuA3PDTbVFh9wmAaShnAEnKyPXR28HPR+2cnP5jmfKO+rgHlVYlVNlkOBVpRh5KNviY4083fE",
+	"NJDRHrXgMEqJ9oLEr9nYrLplmarLtq3zw+DcrNw+KHdEPTsUcBdvGzg+Gf6OVzX8wGSJu2AI3hb0nrQY",
+	"nFD3kPKfhFSLtNpU3G1n65woYHC7YM3hPLqzsaI8AcbLouBAoA+BGcVBiW8R+J/OGx3/x27qkqsWkKM9",
+	"ghT+jnd75nz56m3CAR0NEWUPOgd0ovxkA3wOYgYWtbA3yQ/wUad8yJSL4ALM4ziBgwmGe3+PMnPD8ULZ",
+	"xmyaAYs4UjyCq1nx6LImusub46MAnB2OZ//QyV/yfPDQq+DdTMFyndH1/OliK2y2LmXyANYExj/87Fw/",
+	"rc8Kcva0Do54q18Bm6szutVvUE//AX59+uLrfLFIxFff/208dZ1AlnLmQb6egzeyz402ep2vGmpXdaJ2",
+	"bBIl2xxXbdshkRUJd7GZvnZ0sLM6XdaqHeN6KKB4ZA7sHXjaSNBZLlAodCFSeDPEBMI5vJMvWwugg+Gi",
+	"+3b7vAGf/dEPxy7SSd1+PN7NazmHO5Y5UvaKnajtqW0nPyifru57MaIYNdz3dQGXFI2oqmuJEM+AfOZO",
+	"KmRSIMYzQNC9z2dRL1k7hqHCZIbA1YoZuVtswS83r0EBt/IMeRPNqicwI6uh9Osm+ih1wtMS3aFGyZA0",
+	"zJtw2Ff8Nzp/jSOkegjbi5aikebviHKyPDtV8MU1NQ1mb4m46q7REdPsEtawye14gU3L2wFjHlPmfkTi",
+	"nrLbkfVxLYkjephWWEXgDZqsKlxSMp4pELgwS8yIGsqR3JysK6VX6n6Et3BDBQU5RfYitgx8iwkOv9F7",
+	"U3w5eQVcXykSZJqGrS4EvEVZVDmo2xHzNa7UkMHpXdcUmqOuazQ4f0dSEMrzhnxEheeiMsUIJt3QRu7W",
+	"tAQbytRhhYCzk2fRHeuZuTkIc4AIrVdreUhYw1LYkwJHhNdSJG0SmgRNPp6QyEwv2/vPw1ckAuWvpNmr",
+	"IXQkoC6pbtB2v6QFCcfg0hVBq0fp1ZBaWEIgmw/KwxvDucO14pjmoT3CbPhkw0k1mcj2AGmEeacYu0sr",
+	"+21JvEGemfTG4qTKnM1twkwjlcYehb18xl5YEx8LhKuoNYm1H88ByRubqvGn2i1M1hqYr8ZtY+5205Fb",
+	"2f5257+N2Rij5oBNzBGxtY0ZPyUwfQTT+QFHyAhoJ3sp8Ep9fWvs3+XDpGigBdoz897yEBiZB9p0+0rE",
+	"f5Bx9wiSMb2Bt5vzdjL5hhKx/piPVwrAj/eAdXZuIBwnjj9otH+Ww0/gkGVEaNgxa0QFjjkKFBm4vPrp",
+	"2occ1qgsMrBCBHGsmjTQXPsBSZGoLpmDVz2VO08EgpsMCIYgr9n2C70r1ws1m72zW77YHrzDRThJpc9O",
+	"t5eeIeUeVr/4IJxbdMvnNxX5eWPG3R4t9WGkq9fMAInz9W6kTpJBFRnrjZIRrP/uSM5f9exn3+/H4fsN",
+	"iXGI69cwwNE9v3qeIY5fEXwzRkbSXBuOP2kyrXHOeECHVif2dRH4WyoTw6n7qfP7oD4rktSoiHojmHWU",
+	"lCOVmNlsnhF2kEiTcr++K5/7qOwmvG2MbukeUNmQ3Zu/Q+PhYu0TLILXm4WI3eHtwOgfTXA56qMSOwR2",
+	"QJ6m/m2h8zR7sBPcuye7clmc6qSYJ3hpv/minVXXM7BM8yLUZnlNljefoHqYHq+uZu3gqhN74Prn6P57",
+	"yaMaoL1Y6HIg6tEO6sX36FyYgnqSFnQHIHcStkrOP5ipBtVgyGXKBxOVo3vprHTBxEestyTAY5hF1193",
+	"sgy6Q4KAckVHYHQSXgmKaWIGHcwv8vSLehlGF7lvYIFssU6fvlUlTYIhpH+VAwBpH2ayli0DmFS1yACt",
+	"RVXruKlpl0nUDfNWrS4pcw4B7Vs3eUimZ3YiEa2XWW/Uiv9Gbu3h0Ry9hmU54oATkkLhWaKT6MJh+Tmd",
+	"gq4If6TgnmYXRf0hbBlfjNmrufzjOnLn+uyGIXQTHXc9Q3Uo06AMM/W53Nqo44V+Nw62Z9F1VcZNpZ5B",
+	"UHk/Td7x/B3xl8Jp/ygkt4mG0XqyTP0Mzlzm+Rqv1ia4XUvf6gqyogxqy1SdR5qt/bzHjXD3d879Qa1N",
+	"nnPU6gIaLborjzll4tU2XXms7uebZTNEZGHxH/ajuaTXXn5prfn2xa/+XqQmqD+puhmDXOfKlvq8E1BF",
+	"gg445eABnFB9Ul++z/6m/ry77ijctdvpbY4G2QCTNtm9C7nV6gEPa0sbnNg73HrVQsBuOklFy+oGIaFl",
+	"TI+ESyykTDLEZXmr9RM2TuZZVIrnkva9/2z+jlxiLqD0SpoUGz0fXNA77ah0c2TuqsJrHDzv23GA+zUi",
+	"fgFggXK4Qe6ttNy/Mb8eIP8T+wl2sWMb2gPceBYx6duCFB1a+51uJaY6Iq8AVjteSWGhWylPxeEBPOHt",
+	"lP5aQWQ3JZcrNVAMOBKjJMDvcsbXpVOt0tw+B9fIOM/hHcTqcr7A4x40llY36OjakAVyzmXpxu7ZnK6R",
+	"+JjZM4JzPEMGmPe18gblnjEn7lkep2sZyrpbogfwlLvPf987I7zZhUlsZvWZKsq5eYi9ctzeaTURx7gO",
+	"YlovZnKKgaR3HfZ6yY/JkspNrRaNy1xbLfkH0P3oHcQfiUP8gobpigCJDoHT+YrSBBrLCepiyIH3yHjW",
+	"cxpA6j1tgavdIzxCwTvE4AqBXy+/AQWWmcl1Kbbm7jb8Z90ogVSHfdtuqnm1epw18/b6J8V28vzlZtTp",
+	"xg4SvATYpXbyHaWSLvtzsfVBZr+OOJ9uDmxOjJp/XEqMo8clXE1xN072OY9GV+tfudT4Q7NpJN2DRPuo",
+	"V1qw8R4lyyad658GYbyU62K6IY6W1I2dJhM6hYJdt3R6jvfTf+rXdLZQ9WgXdVI2AaMEx+XBSTyGa+9V",
+	"MWqDT9TpOGdUXXDYfR435yOtyXf0F3SLeOOHmex+zv/gfPk2Og8+qGsS52tJzKOl3KSmOlwGVNB5QLOT",
+	"FeZC82cGcrrZYM5tYYiyzzREjfbTkXCMuJjWs74G75O6jraxuKluo/W4nvYy2orRJS6RJXDjQtr9OW+o",
+	"xk1Y4lF+uvTEZ0FBotp+glsedRIOXSYOdzpnUhfwEd/udf6O/OZM3qDDNgdbBPUmZ81yl4qoGlSfn54/",
+	"Pzs3hvOLwwznyTR9jNXfzGJ///3333/7DeimpzuzN+Ubs2RXCbnc07Ogcyom4tn5LOiUeprolHogSILu",
+	"BujF+ViAHkXWD99pTCyv1043TopJZH/nnKMl3miSXomn9wSxcJfJQPCgjk2SQjX0TSACqc5bcZa9vSd5",
+	"l8BdGeg+XqO9BepwJrIHHY8nQ4wdJbI6hKB3fsSmi5vbDeVgftqjU1vMK4lWWoFVDiToWqZ8H7ceJurs",
+	"n/YJXAP3SeXbNym2h2LuZKsOvpm0C9wO4YmbvyUlRx1Vx1wiljxshIG3hPzMwX8jRl2QGlpvZDxGiZb6",
+	"q85eBz5yJUc6kl30WcKOF3AMyHZgUyN07DO2nmSyM45KrRpzwjH19bGI3MM42C5HBZjozC0dl5CGEirC",
+	"NrlqCPmgTuxqhBVUzTllUXghqken7FDP/69q7UdxVOkrCLoyleA2SFTSn+Q6OrKmPocVTiSlDo0mKFZ7",
+	"1BhCjpgwuYs++0R1jB4qtXJYxO7SBY8/QEwIEkGLlpqVs4vZWoiKX5ycaCXN5hv93FwV7+dsWwk6J0gk",
+	"tpgbxMWQEYV+bsCIl+huyIAFukuO9/7h/w0AfHWMBBAHAQA=",
 }
 
 // GetSwagger returns the content of the embedded swagger specification file
@@ -1,20 +1,44 @@
 package chain
 
 import (
+	"strings"
+
 	"github.com/dailycrypto-me/daily-indexer/internal/common"
+	"github.com/dailycrypto-me/daily-indexer/internal/events"
 )
 
 type initialValidator struct {
 	Address     string            `json:"address"`
+	Owner       string            `json:"owner"`
+	Commission  string            `json:"commission"`
+	Description string            `json:"description"`
+	Endpoint    string            `json:"endpoint"`
 	Delegations map[string]string `json:"delegations"`
 }
 
+// ToValidatorEvent returns registration event for the validator profile of the initial validator
+func (v *initialValidator) ToValidatorEvent() events.LogValidator {
+	owner := strings.ToLower(v.Owner)
+	commission := common.ParseUInt(v.Commission)
+	description := v.Description
+	endpoint := v.Endpoint
+	return events.LogValidator{
+		EventName:   events.ValidatorRegisteredEvent,
+		Validator:   strings.ToLower(v.Address),
+		Owner:       &owner,
+		Commission:  &commission,
+		Description: &description,
+		Endpoint:    &endpoint,
+	}
+}
+
 type DposConfig struct {
 	BlocksPerYear               string             `json:"blocks_per_year"`
 	DagProposersReward          string             `json:"dag_proposers_reward"`
 	MaxBlockAuthorReward        string             `json:"max_block_author_reward"`
 	EligibilityBalanceThreshold string             `json:"eligibility_balance_threshold"`
 	YieldPercentage             string             `json:"yield_percentage"`
+	DelegationLockingPeriod     string             `json:"delegation_locking_period"`
 	InitialValidators           []initialValidator `json:"initial_validators"`
 }
 
@@ -39,6 +63,7 @@ func (g *GenesisObject) ToChainConfig() (c *common.ChainConfig) {
 	c.DagProposersReward = common.ParseStringToBigInt(g.Dpos.DagProposersReward)
 	c.MaxBlockAuthorReward = common.ParseStringToBigInt(g.Dpos.MaxBlockAuthorReward)
 	c.EligibilityBalanceThreshold = common.ParseStringToBigInt(g.Dpos.EligibilityBalanceThreshold)
+	c.DelegationLockingPeriod = common.ParseUInt(g.Dpos.DelegationLockingPeriod)
 	c.Hardforks = g.Hardforks
 	return
 }
@@ -96,4 +96,6 @@ func TestDagBlockParsing(t *testing.T) {
 	assert.Equal(t, dag.Dag.Timestamp, uint64(0x65fe340e))
 	assert.Equal(t, dag.Sender, "0xe90958a7e4a95ee0c3e2b36dcf6344835755fcfd")
 	assert.Equal(t, dag.Vdf.Difficulty, uint16(0x10))
+	assert.Equal(t, dag.Pivot, "0x276505a89984b9d7ef6611de0f741cfcc67d6b361c17114acb6eed19d9b60c31")
+	assert.Equal(t, len(dag.Tips), 0)
 }
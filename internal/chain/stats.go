@@ -42,6 +42,7 @@ func (s *Stats) AddPbft(lastPbft *models.Pbft) {
 
 	s.blockTimeDiff = lastPbft.Timestamp - firstPbft.Timestamp
 	s.stats.EndBlock = lastPbft.Number
+	s.stats.EndTimestamp = lastPbft.Timestamp
 
 	s.stats.BlockInterval = float32(s.blockTimeDiff) / float32(s.interval)
 	s.stats.Tps = float32(s.totalTrxCount) / float32(s.blockTimeDiff)
@@ -111,8 +111,16 @@ func (t *Transaction) UnmarshalJSON(data []byte) error {
 	return nil
 }
 
+// GetStorage returns transaction record with the receipt fields
 func (b *Transaction) GetStorage() (trx storage.Transaction) {
-	return b.Transaction
+	trx = b.Transaction
+	trx.ContractAddress = b.ContractAddress
+	trx.GasPrice = b.GasPrice
+	trx.GasUsed = b.GasUsed
+	trx.Nonce = b.Nonce
+	trx.TransactionIndex = b.TransactionIndex
+	trx.Version = storage.TransactionVersion
+	return
 }
 
 func (t *Transaction) GetFee() *big.Int {
@@ -46,6 +46,8 @@ func (b *Block) GetModel() (pbft *models.Pbft) {
 type DagBlock struct {
 	models.Dag
 	Sender       string   `json:"sender"`
+	Pivot        string   `json:"pivot"`
+	Tips         []string `json:"tips"`
 	Transactions []string `json:"transactions"`
 	Vdf          struct {
 		Difficulty uint16 `json:"difficulty"`
@@ -59,6 +61,8 @@ func (b *DagBlock) UnmarshalJSON(data []byte) error {
 		Timestamp string `json:"timestamp"`
 
 		Sender       string   `json:"sender"`
+		Pivot        string   `json:"pivot"`
+		Tips         []string `json:"tips"`
 		Transactions []string `json:"transactions"`
 
 		Vdf struct {
@@ -69,6 +73,8 @@ func (b *DagBlock) UnmarshalJSON(data []byte) error {
 		panic(err)
 	}
 	b.Sender = rawStruct.Sender
+	b.Pivot = rawStruct.Pivot
+	b.Tips = rawStruct.Tips
 	b.Transactions = rawStruct.Transactions
 
 	b.Dag.Hash = rawStruct.Hash
@@ -113,6 +119,8 @@ type TraceEntry struct {
 	TraceAddress []uint16         `json:"traceAddress"`
 	Type         string           `json:"type"`
 	Result       TraceEntryResult `json:"result"`
+	// Error is set for failed calls, e.g. "Reverted" or "Out of gas"
+	Error string `json:"error,omitempty"`
 }
 
 type TransactionTrace struct {
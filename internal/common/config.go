@@ -89,6 +89,7 @@ type ChainConfig struct {
 	DagProposersReward          *big.Int
 	MaxBlockAuthorReward        *big.Int
 	EligibilityBalanceThreshold *big.Int
+	DelegationLockingPeriod     uint64
 	Hardforks                   HardforksConfig
 }
 
@@ -107,8 +108,11 @@ type Config struct {
 	Chain                         *ChainConfig
 	TotalYieldSavingInterval      uint64
 	ValidatorsYieldSavingInterval uint64
+	SupplySavingInterval          uint64
 	SyncQueueLimit                uint64
 	ChainStatsInterval            int
+	// LockedAddresses are team, treasury and other addresses which balances aren't counted in the circulating supply
+	LockedAddresses []string
 }
 
 func (c *Config) IsEligible(stake *big.Int) bool {
@@ -118,11 +122,20 @@ func (c *Config) IsEligible(stake *big.Int) bool {
 	return false
 }
 
+// GetEligibilityThreshold returns minimal stake of the eligible validator or nil if chain config isn't loaded yet
+func (c *Config) GetEligibilityThreshold() *big.Int {
+	if c.Chain == nil {
+		return nil
+	}
+	return c.Chain.EligibilityBalanceThreshold
+}
+
 func DefaultConfig() *Config {
 	return &Config{
 		Chain:                         DefaultChainConfig(),
 		TotalYieldSavingInterval:      1000,
 		ValidatorsYieldSavingInterval: 1000,
+		SupplySavingInterval:          1000,
 		SyncQueueLimit:                10,
 	}
 }
@@ -64,6 +64,18 @@ func ParseStringToBigInt(v string) *big.Int {
 	return a
 }
 
+// ParseAddressesList parses comma separated addresses. Addresses are lowercased, empty entries are skipped
+func ParseAddressesList(s string) []string {
+	ret := make([]string, 0)
+	for _, address := range strings.Split(s, ",") {
+		address = strings.ToLower(strings.TrimSpace(address))
+		if address != "" {
+			ret = append(ret, address)
+		}
+	}
+	return ret
+}
+
 func ParseFloat(s string) (v float64) {
 	if len(s) == 0 {
 		return
@@ -1,6 +1,10 @@
 package contracts
 
-import "github.com/dailycrypto-me/daily-go-client/daily_client/dpos_contract_client/dpos_interface"
+import (
+	"encoding/json"
+
+	"github.com/dailycrypto-me/daily-go-client/daily_client/dpos_contract_client/dpos_interface"
+)
 
 const ClaimNative = `[{"inputs":[{"internalType":"address","name":"_trustedAccountAddress","type":"address"}],"stateMutability":"payable","type":"constructor"},{"anonymous":false,"inputs":[{"indexed":true,"internalType":"address","name":"_address","type":"address"},{"indexed":true,"internalType":"uint256","name":"_nonce","type":"uint256"},{"indexed":false,"internalType":"uint256","name":"_value","type":"uint256"}],"name":"Claimed","type":"event"},{"stateMutability":"payable","type":"receive"},{"inputs":[{"internalType":"address","name":"_address","type":"address"},{"internalType":"uint256","name":"_value","type":"uint256"},{"internalType":"uint256","name":"_nonce","type":"uint256"}],"name":"getClaimedAmount","outputs":[{"internalType":"uint256","name":"","type":"uint256"}],"stateMutability":"view","type":"function"},{"inputs":[{"internalType":"address payable","name":"_address","type":"address"},{"internalType":"uint256","name":"_value","type":"uint256"},{"internalType":"uint256","name":"_nonce","type":"uint256"},{"internalType":"bytes","name":"_sig","type":"bytes"}],"name":"claim","outputs":[],"stateMutability":"nonpayable","type":"function"}]`
 
@@ -8,9 +12,32 @@ const Multicall = `[{"inputs":[{"components":[{"internalType":"address","name":"
 
 const MultisendNative = `[{"anonymous":false,"inputs":[{"indexed":false,"internalType":"uint256","name":"amount","type":"uint256"}],"name":"SentBack","type":"event"},{"anonymous":false,"inputs":[{"indexed":false,"internalType":"address","name":"recipient","type":"address"},{"indexed":false,"internalType":"uint256","name":"amount","type":"uint256"}],"name":"TokensSent","type":"event"},{"inputs":[{"internalType":"address payable[]","name":"_recipients","type":"address[]"},{"internalType":"uint256[]","name":"_amounts","type":"uint256[]"}],"name":"multisendToken","outputs":[],"stateMutability":"payable","type":"function"}]`
 
+// DposV2Events are undelegation events with ids that aren't included in the DPOS interface ABI
+const DposV2Events = `[{"anonymous":false,"inputs":[{"indexed":true,"internalType":"address","name":"delegator","type":"address"},{"indexed":true,"internalType":"address","name":"validator","type":"address"},{"indexed":true,"internalType":"uint64","name":"undelegation_id","type":"uint64"},{"indexed":false,"internalType":"uint256","name":"amount","type":"uint256"}],"name":"UndelegatedV2","type":"event"},{"anonymous":false,"inputs":[{"indexed":true,"internalType":"address","name":"delegator","type":"address"},{"indexed":true,"internalType":"address","name":"validator","type":"address"},{"indexed":true,"internalType":"uint64","name":"undelegation_id","type":"uint64"},{"indexed":false,"internalType":"uint256","name":"amount","type":"uint256"}],"name":"UndelegateConfirmedV2","type":"event"},{"anonymous":false,"inputs":[{"indexed":true,"internalType":"address","name":"delegator","type":"address"},{"indexed":true,"internalType":"address","name":"validator","type":"address"},{"indexed":true,"internalType":"uint64","name":"undelegation_id","type":"uint64"},{"indexed":false,"internalType":"uint256","name":"amount","type":"uint256"}],"name":"UndelegateCanceledV2","type":"event"}]`
+
+// Dpos is the DPOS contract interface ABI extended with V2 events
+var Dpos = mergeABIs(dpos_interface.DposInterfaceABI, DposV2Events)
+
+// ContractABIs are built-in ABIs that are always registered in Abis registry
 var ContractABIs = map[string]string{
 	"0xfce7a3121b42664aad145712e1c2bf2e38f60aa1": Multicall,
 	"0x1578f035581f664efa85a6da822464bd9edd8850": MultisendNative,
 	"0xf3b803a8f4c4fc3fbe454b6438dc0ed22735f01b": ClaimNative,
-	"0x00000000000000000000000000000000000000fe": dpos_interface.DposInterfaceABI,
+	"0x00000000000000000000000000000000000000fe": Dpos,
+}
+
+func mergeABIs(abis ...string) string {
+	merged := make([]json.RawMessage, 0)
+	for _, a := range abis {
+		var entries []json.RawMessage
+		if err := json.Unmarshal([]byte(a), &entries); err != nil {
+			panic(err)
+		}
+		merged = append(merged, entries...)
+	}
+	ret, err := json.Marshal(merged)
+	if err != nil {
+		panic(err)
+	}
+	return string(ret)
 }
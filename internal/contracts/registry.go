@@ -0,0 +1,123 @@
+package contracts
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	ethcommon "github.com/ethereum/go-ethereum/common"
+)
+
+type registryEntry struct {
+	raw    string
+	parsed *abi.ABI
+}
+
+// Registry keeps contract ABIs by contract address. ABIs are parsed only once on registration
+type Registry struct {
+	mutex sync.RWMutex
+	abis  map[string]registryEntry
+}
+
+// Abis is the registry used for decoding transactions and events. It is initialized with the built-in ABIs
+var Abis = MakeDefaultRegistry()
+
+func MakeRegistry() *Registry {
+	return &Registry{abis: make(map[string]registryEntry)}
+}
+
+func MakeDefaultRegistry() *Registry {
+	r := MakeRegistry()
+	for address, contractAbi := range ContractABIs {
+		if _, err := r.Register(address, contractAbi); err != nil {
+			panic(fmt.Sprintf("failed to parse built-in ABI for %s: %v", address, err))
+		}
+	}
+	return r
+}
+
+// Register parses ABI and saves it for the specified address. Previously registered ABI will be replaced
+func (r *Registry) Register(address, abiJSON string) (*abi.ABI, error) {
+	if !ethcommon.IsHexAddress(address) {
+		return nil, fmt.Errorf("invalid contract address %s", address)
+	}
+	normalized, err := NormalizeABI([]byte(abiJSON))
+	if err != nil {
+		return nil, err
+	}
+	parsed, err := abi.JSON(strings.NewReader(normalized))
+	if err != nil {
+		return nil, err
+	}
+
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+	r.abis[strings.ToLower(address)] = registryEntry{raw: normalized, parsed: &parsed}
+	return &parsed, nil
+}
+
+// Get returns parsed ABI for the address or nil if there is no ABI registered
+func (r *Registry) Get(address string) *abi.ABI {
+	r.mutex.RLock()
+	defer r.mutex.RUnlock()
+	return r.abis[strings.ToLower(address)].parsed
+}
+
+// GetRaw returns ABI JSON for the address or empty string if there is no ABI registered
+func (r *Registry) GetRaw(address string) string {
+	r.mutex.RLock()
+	defer r.mutex.RUnlock()
+	return r.abis[strings.ToLower(address)].raw
+}
+
+// NormalizeABI accepts either ABI array or compiler artifact with an "abi" field and returns ABI array JSON
+func NormalizeABI(data []byte) (string, error) {
+	data = bytes.TrimSpace(data)
+	if len(data) > 0 && data[0] == '{' {
+		var artifact struct {
+			ABI json.RawMessage `json:"abi"`
+		}
+		if err := json.Unmarshal(data, &artifact); err != nil {
+			return "", err
+		}
+		if len(artifact.ABI) == 0 {
+			return "", fmt.Errorf("artifact doesn't contain abi field")
+		}
+		data = artifact.ABI
+	}
+	var entries []json.RawMessage
+	if err := json.Unmarshal(data, &entries); err != nil {
+		return "", fmt.Errorf("ABI should be a JSON array: %w", err)
+	}
+	compacted := new(bytes.Buffer)
+	if err := json.Compact(compacted, data); err != nil {
+		return "", err
+	}
+	return compacted.String(), nil
+}
+
+// ReadABIDir reads ABIs from files named as `<contract address>.json` in the specified directory
+func ReadABIDir(dir string) (map[string]string, error) {
+	files, err := filepath.Glob(filepath.Join(dir, "*.json"))
+	if err != nil {
+		return nil, err
+	}
+	ret := make(map[string]string, len(files))
+	for _, file := range files {
+		address := strings.TrimSuffix(filepath.Base(file), filepath.Ext(file))
+		if !ethcommon.IsHexAddress(address) {
+			return nil, fmt.Errorf("file name %s isn't a contract address", filepath.Base(file))
+		}
+		data, err := os.ReadFile(file)
+		if err != nil {
+			return nil, err
+		}
+		ret[strings.ToLower(address)] = string(data)
+	}
+	return ret, nil
+}
@@ -0,0 +1,57 @@
+package contracts
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+const erc20TransferAbi = `[{"anonymous":false,"inputs":[{"indexed":true,"name":"from","type":"address"},{"indexed":true,"name":"to","type":"address"},{"indexed":false,"name":"value","type":"uint256"}],"name":"Transfer","type":"event"},{"inputs":[{"name":"to","type":"address"},{"name":"value","type":"uint256"}],"name":"transfer","outputs":[{"name":"","type":"bool"}],"stateMutability":"nonpayable","type":"function"}]`
+
+func TestDefaultRegistry(t *testing.T) {
+	for address := range ContractABIs {
+		assert.NotNil(t, Abis.Get(address), address)
+	}
+	assert.NotNil(t, Abis.Get("0x00000000000000000000000000000000000000FE"))
+	assert.Nil(t, Abis.Get("0x0000000000000000000000000000000000000001"))
+}
+
+func TestRegister(t *testing.T) {
+	r := MakeRegistry()
+	address := "0xCa67d0c50C4f5363aA47a11C54486aD8F28A7F2b"
+
+	parsed, err := r.Register(address, erc20TransferAbi)
+	assert.NoError(t, err)
+	assert.Equal(t, "transfer(address,uint256)", parsed.Methods["transfer"].Sig)
+	assert.Equal(t, parsed, r.Get(address))
+	assert.Equal(t, erc20TransferAbi, r.GetRaw(address))
+
+	// artifact format is also accepted
+	_, err = r.Register(address, `{"contractName":"Token","abi":`+erc20TransferAbi+`}`)
+	assert.NoError(t, err)
+	assert.Equal(t, erc20TransferAbi, r.GetRaw(address))
+
+	_, err = r.Register("0x1234", erc20TransferAbi)
+	assert.Error(t, err)
+	_, err = r.Register(address, `{"contractName":"Token"}`)
+	assert.Error(t, err)
+	_, err = r.Register(address, `[{"type":"function","name":"f","inputs":"a"}]`)
+	assert.Error(t, err)
+}
+
+func TestReadABIDir(t *testing.T) {
+	dir := t.TempDir()
+	address := "0xca67d0c50c4f5363aa47a11c54486ad8f28a7f2b"
+	assert.NoError(t, os.WriteFile(filepath.Join(dir, address+".json"), []byte(erc20TransferAbi), 0644))
+	assert.NoError(t, os.WriteFile(filepath.Join(dir, "README.md"), []byte("not an abi"), 0644))
+
+	abis, err := ReadABIDir(dir)
+	assert.NoError(t, err)
+	assert.Equal(t, map[string]string{address: erc20TransferAbi}, abis)
+
+	assert.NoError(t, os.WriteFile(filepath.Join(dir, "token.json"), []byte(erc20TransferAbi), 0644))
+	_, err = ReadABIDir(dir)
+	assert.Error(t, err)
+}
@@ -0,0 +1,259 @@
+package contracts
+
+import (
+	_ "embed"
+	"fmt"
+	"strings"
+	"sync"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	ethcommon "github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+)
+
+//go:embed signatures.txt
+var builtInSignatures string
+
+// SignatureDB keeps text signatures of functions and events by their 4-byte selector and topic hash.
+// It is used to guess method and event names of contracts that have no registered ABI
+type SignatureDB struct {
+	mutex   sync.RWMutex
+	methods map[[4]byte][]string
+	events  map[ethcommon.Hash][]string
+}
+
+// Signatures is the database used for decoding transactions and events of unknown contracts. It is initialized with the built-in signatures
+var Signatures = MakeDefaultSignatureDB()
+
+func MakeSignatureDB() *SignatureDB {
+	return &SignatureDB{methods: make(map[[4]byte][]string), events: make(map[ethcommon.Hash][]string)}
+}
+
+func MakeDefaultSignatureDB() *SignatureDB {
+	db := MakeSignatureDB()
+	for _, line := range strings.Split(builtInSignatures, "\n") {
+		line = strings.TrimSpace(line)
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		if _, err := db.Add(line); err != nil {
+			panic(fmt.Sprintf("failed to parse built-in signature %s: %v", line, err))
+		}
+	}
+	return db
+}
+
+// Add validates and saves the signature. Returns normalized signature that should be used to persist it
+func (db *SignatureDB) Add(signature string) (string, error) {
+	normalized, _, err := parseSignature(signature)
+	if err != nil {
+		return "", err
+	}
+	hash := crypto.Keccak256Hash([]byte(normalized))
+	var selector [4]byte
+	copy(selector[:], hash[:4])
+
+	db.mutex.Lock()
+	defer db.mutex.Unlock()
+	db.methods[selector] = appendUnique(db.methods[selector], normalized)
+	db.events[hash] = appendUnique(db.events[hash], normalized)
+	return normalized, nil
+}
+
+// NormalizeSignature validates the signature and returns it without whitespaces
+func NormalizeSignature(signature string) (string, error) {
+	normalized, _, err := parseSignature(signature)
+	return normalized, err
+}
+
+// GetMethods returns all known function signatures with the specified 4-byte selector
+func (db *SignatureDB) GetMethods(selector []byte) []string {
+	if len(selector) < 4 {
+		return nil
+	}
+	var key [4]byte
+	copy(key[:], selector[:4])
+
+	db.mutex.RLock()
+	defer db.mutex.RUnlock()
+	return append([]string(nil), db.methods[key]...)
+}
+
+// GetEvents returns all known event signatures with the specified topic hash
+func (db *SignatureDB) GetEvents(topic ethcommon.Hash) []string {
+	db.mutex.RLock()
+	defer db.mutex.RUnlock()
+	return append([]string(nil), db.events[topic]...)
+}
+
+// DecodeMethod finds signature by the selector of transaction input and tries to decode the arguments with it.
+// If arguments can't be decoded with any of the candidates the first signature is returned without params
+func (db *SignatureDB) DecodeMethod(input []byte) (signature string, params []interface{}, err error) {
+	if len(input) < 4 {
+		return "", nil, fmt.Errorf("input is too short")
+	}
+	candidates := db.GetMethods(input[:4])
+	if len(candidates) == 0 {
+		return "", nil, nil
+	}
+	for _, candidate := range candidates {
+		_, args, err := parseSignature(candidate)
+		if err != nil {
+			continue
+		}
+		params, err := args.Unpack(input[4:])
+		if err == nil {
+			return candidate, params, nil
+		}
+	}
+	return candidates[0], nil, nil
+}
+
+// DecodeEvent finds signature by the first topic and tries to decode the arguments with it.
+// Indexed arguments aren't known from the text signature, so the leading arguments are treated as indexed
+// according to the number of topics. Indexed arguments of dynamic types are returned as topic hashes
+func (db *SignatureDB) DecodeEvent(topics []ethcommon.Hash, data []byte) (signature string, params []interface{}, err error) {
+	if len(topics) == 0 {
+		return "", nil, nil
+	}
+	candidates := db.GetEvents(topics[0])
+	if len(candidates) == 0 {
+		return "", nil, nil
+	}
+	for _, candidate := range candidates {
+		_, args, err := parseSignature(candidate)
+		if err != nil {
+			continue
+		}
+		params, err := decodeEventArgs(args, topics[1:], data)
+		if err == nil {
+			return candidate, params, nil
+		}
+	}
+	return candidates[0], nil, nil
+}
+
+func decodeEventArgs(args abi.Arguments, indexed []ethcommon.Hash, data []byte) ([]interface{}, error) {
+	if len(indexed) > len(args) {
+		return nil, fmt.Errorf("more indexed topics than arguments")
+	}
+	params := make([]interface{}, 0, len(args))
+	for i, topic := range indexed {
+		if isDynamicType(args[i].Type) {
+			params = append(params, topic.Hex())
+			continue
+		}
+		values, err := abi.Arguments{{Type: args[i].Type}}.Unpack(topic.Bytes())
+		if err != nil {
+			return nil, err
+		}
+		params = append(params, values[0])
+	}
+	values, err := args[len(indexed):].Unpack(data)
+	if err != nil {
+		return nil, err
+	}
+	return append(params, values...), nil
+}
+
+func isDynamicType(t abi.Type) bool {
+	switch t.T {
+	case abi.StringTy, abi.BytesTy, abi.SliceTy, abi.ArrayTy, abi.TupleTy:
+		return true
+	}
+	return false
+}
+
+func appendUnique(list []string, value string) []string {
+	for _, v := range list {
+		if v == value {
+			return list
+		}
+	}
+	return append(list, value)
+}
+
+// parseSignature parses signature like `name(type1,(type2,type3)[])` and returns it without whitespaces along with its arguments
+func parseSignature(signature string) (string, abi.Arguments, error) {
+	normalized := strings.Join(strings.Fields(signature), "")
+	open := strings.Index(normalized, "(")
+	if open <= 0 || !strings.HasSuffix(normalized, ")") {
+		return "", nil, fmt.Errorf("invalid signature %s", signature)
+	}
+	types, err := splitTypes(normalized[open+1 : len(normalized)-1])
+	if err != nil {
+		return "", nil, fmt.Errorf("invalid signature %s: %w", signature, err)
+	}
+	args := make(abi.Arguments, 0, len(types))
+	for _, t := range types {
+		marshaling, err := parseType(t)
+		if err != nil {
+			return "", nil, fmt.Errorf("invalid signature %s: %w", signature, err)
+		}
+		parsed, err := abi.NewType(marshaling.Type, "", marshaling.Components)
+		if err != nil {
+			return "", nil, fmt.Errorf("invalid signature %s: %w", signature, err)
+		}
+		args = append(args, abi.Argument{Type: parsed})
+	}
+	return normalized, args, nil
+}
+
+func parseType(t string) (abi.ArgumentMarshaling, error) {
+	if !strings.HasPrefix(t, "(") {
+		return abi.ArgumentMarshaling{Type: t}, nil
+	}
+	closing := strings.LastIndex(t, ")")
+	if closing < 0 {
+		return abi.ArgumentMarshaling{}, fmt.Errorf("unbalanced parentheses in %s", t)
+	}
+	types, err := splitTypes(t[1:closing])
+	if err != nil {
+		return abi.ArgumentMarshaling{}, err
+	}
+	components := make([]abi.ArgumentMarshaling, 0, len(types))
+	for i, componentType := range types {
+		component, err := parseType(componentType)
+		if err != nil {
+			return abi.ArgumentMarshaling{}, err
+		}
+		component.Name = fmt.Sprintf("field%d", i)
+		components = append(components, component)
+	}
+	return abi.ArgumentMarshaling{Type: "tuple" + t[closing+1:], Components: components}, nil
+}
+
+// splitTypes splits comma separated list of types ignoring commas inside of tuples
+func splitTypes(list string) ([]string, error) {
+	if list == "" {
+		return nil, nil
+	}
+	var types []string
+	depth, start := 0, 0
+	for i, c := range list {
+		switch c {
+		case '(':
+			depth++
+		case ')':
+			depth--
+			if depth < 0 {
+				return nil, fmt.Errorf("unbalanced parentheses")
+			}
+		case ',':
+			if depth == 0 {
+				types = append(types, list[start:i])
+				start = i + 1
+			}
+		}
+	}
+	if depth != 0 {
+		return nil, fmt.Errorf("unbalanced parentheses")
+	}
+	types = append(types, list[start:])
+	for _, t := range types {
+		if t == "" {
+			return nil, fmt.Errorf("empty type")
+		}
+	}
+	return types, nil
+}
@@ -0,0 +1,91 @@
+package contracts
+
+import (
+	"math/big"
+	"testing"
+
+	ethcommon "github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDefaultSignatures(t *testing.T) {
+	db := MakeDefaultSignatureDB()
+	assert.Equal(t, []string{"transfer(address,uint256)"}, db.GetMethods(hexutil.MustDecode("0xa9059cbb")))
+	assert.Equal(t, []string{"Transfer(address,address,uint256)"}, db.GetEvents(crypto.Keccak256Hash([]byte("Transfer(address,address,uint256)"))))
+	assert.Empty(t, db.GetMethods(hexutil.MustDecode("0x00000000")))
+}
+
+func TestAddSignature(t *testing.T) {
+	db := MakeSignatureDB()
+
+	normalized, err := db.Add("swap( (address, uint256)[], bytes )")
+	assert.NoError(t, err)
+	assert.Equal(t, "swap((address,uint256)[],bytes)", normalized)
+	selector := crypto.Keccak256([]byte(normalized))[:4]
+	assert.Equal(t, []string{normalized}, db.GetMethods(selector))
+
+	// adding the same signature twice doesn't duplicate it
+	_, err = db.Add(normalized)
+	assert.NoError(t, err)
+	assert.Len(t, db.GetMethods(selector), 1)
+
+	for _, invalid := range []string{"", "transfer", "(address)", "f(address", "f((address)", "f(address,)", "f(foo)"} {
+		_, err := db.Add(invalid)
+		assert.Error(t, err, invalid)
+	}
+}
+
+func TestSignaturesDecodeMethod(t *testing.T) {
+	db := MakeDefaultSignatureDB()
+
+	input := hexutil.MustDecode("0xa9059cbb000000000000000000000000ed4d5f4f3641cbc056e466d15dbe2403e38056f800000000000000000000000000000000000000000000000000000000000003e8")
+	sig, params, err := db.DecodeMethod(input)
+	assert.NoError(t, err)
+	assert.Equal(t, "transfer(address,uint256)", sig)
+	assert.Equal(t, []interface{}{ethcommon.HexToAddress("0xed4d5f4f3641cbc056e466d15dbe2403e38056f8"), big.NewInt(1000)}, params)
+
+	// known selector with malformed arguments returns just the name
+	sig, params, err = db.DecodeMethod(input[:10])
+	assert.NoError(t, err)
+	assert.Equal(t, "transfer(address,uint256)", sig)
+	assert.Nil(t, params)
+
+	sig, _, err = db.DecodeMethod(hexutil.MustDecode("0x12345678"))
+	assert.NoError(t, err)
+	assert.Equal(t, "", sig)
+
+	_, _, err = db.DecodeMethod([]byte{1})
+	assert.Error(t, err)
+}
+
+func TestSignaturesDecodeEvent(t *testing.T) {
+	db := MakeDefaultSignatureDB()
+
+	topics := []ethcommon.Hash{
+		crypto.Keccak256Hash([]byte("Transfer(address,address,uint256)")),
+		ethcommon.HexToHash("0x0000000000000000000000000dc0d841f962759da25547c686fa440cf6c28c61"),
+		ethcommon.HexToHash("0x000000000000000000000000ed4d5f4f3641cbc056e466d15dbe2403e38056f8"),
+	}
+	data := hexutil.MustDecode("0x00000000000000000000000000000000000000000000000000000000000003e8")
+	sig, params, err := db.DecodeEvent(topics, data)
+	assert.NoError(t, err)
+	assert.Equal(t, "Transfer(address,address,uint256)", sig)
+	assert.Equal(t, []interface{}{
+		ethcommon.HexToAddress("0x0dc0d841f962759da25547c686fa440cf6c28c61"),
+		ethcommon.HexToAddress("0xed4d5f4f3641cbc056e466d15dbe2403e38056f8"),
+		big.NewInt(1000),
+	}, params)
+
+	// indexed dynamic arguments are returned as topic
+	uriTopics := []ethcommon.Hash{crypto.Keccak256Hash([]byte("URI(string,uint256)")), crypto.Keccak256Hash([]byte("ipfs://"))}
+	sig, params, err = db.DecodeEvent(uriTopics, data)
+	assert.NoError(t, err)
+	assert.Equal(t, "URI(string,uint256)", sig)
+	assert.Equal(t, []interface{}{uriTopics[1].Hex(), big.NewInt(1000)}, params)
+
+	sig, _, err = db.DecodeEvent([]ethcommon.Hash{{}}, data)
+	assert.NoError(t, err)
+	assert.Equal(t, "", sig)
+}
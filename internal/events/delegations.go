@@ -0,0 +1,69 @@
+package events
+
+import (
+	"fmt"
+	"math/big"
+	"strings"
+
+	"github.com/dailycrypto-me/daily-indexer/internal/common"
+	"github.com/dailycrypto-me/daily-indexer/models"
+	ethcommon "github.com/ethereum/go-ethereum/common"
+)
+
+const (
+	DelegatedEvent           = "Delegated(address,address,uint256)"
+	UndelegatedEvent         = "Undelegated(address,address,uint256)"
+	UndelegateCanceledEvent  = "UndelegateCanceled(address,address,uint256)"
+	RedelegatedEvent         = "Redelegated(address,address,address,uint256)"
+	UndelegateConfirmedEvent = "UndelegateConfirmed(address,address,uint256)"
+
+	UndelegatedV2Event         = "UndelegatedV2(address,address,uint64,uint256)"
+	UndelegateCanceledV2Event  = "UndelegateCanceledV2(address,address,uint64,uint256)"
+	UndelegateConfirmedV2Event = "UndelegateConfirmedV2(address,address,uint64,uint256)"
+)
+
+// delegationTopics are DPOS events that are changing delegated amount or undelegations queue
+var delegationTopics = map[string]bool{
+	DelegatedEvent:             true,
+	UndelegatedEvent:           true,
+	UndelegateCanceledEvent:    true,
+	RedelegatedEvent:           true,
+	UndelegateConfirmedEvent:   true,
+	UndelegatedV2Event:         true,
+	UndelegateCanceledV2Event:  true,
+	UndelegateConfirmedV2Event: true,
+}
+
+func DecodeDelegationTopics(logs []models.EventLog) (decodedEvents []LogDelegation, err error) {
+	for _, log := range logs {
+		if !strings.EqualFold(log.Address, common.DposContractAddress) {
+			continue
+		}
+		name, d, err := DecodeEventDynamic(log)
+		if err != nil {
+			return nil, err
+		}
+		if !delegationTopics[name] {
+			continue
+		}
+		decoded := d.([]interface{})
+		value, _ := big.NewInt(0).SetString(fmt.Sprintf("%v", decoded[0]), 10)
+		event := LogDelegation{
+			EventName:        name,
+			Delegator:        strings.ToLower(ethcommon.HexToAddress(log.Topics[1]).Hex()),
+			Validator:        strings.ToLower(ethcommon.HexToAddress(log.Topics[2]).Hex()),
+			Value:            value,
+			TransactionHash:  log.TransactionHash,
+			TransactionIndex: log.TransactionIndex,
+			LogIndex:         log.LogIndex,
+		}
+		switch name {
+		case RedelegatedEvent:
+			event.ToValidator = strings.ToLower(ethcommon.HexToAddress(log.Topics[3]).Hex())
+		case UndelegatedV2Event, UndelegateCanceledV2Event, UndelegateConfirmedV2Event:
+			event.UndelegationId = ethcommon.HexToHash(log.Topics[3]).Big().Uint64()
+		}
+		decodedEvents = append(decodedEvents, event)
+	}
+	return decodedEvents, err
+}
@@ -21,10 +21,48 @@ var rewardTopics = map[string]bool{
 }
 
 func DecodeEventDynamic(log models.EventLog) (string, any, error) {
-	relevantAbi := contracts.ContractABIs[strings.ToLower(log.Address)]
-	if relevantAbi == "" {
+	contractABI := contracts.Abis.Get(log.Address)
+	if contractABI == nil {
 		return "", nil, nil
 	}
+	return decodeEventWithABI(contractABI, log)
+}
+
+// DecodeEvent decodes the event with contract ABI. If there is no ABI for the contract it tries to guess the event by known signatures
+func DecodeEvent(log models.EventLog) (name string, params any, guess bool, err error) {
+	contractABI := contracts.Abis.Get(log.Address)
+	if contractABI != nil {
+		name, params, err = decodeEventWithABI(contractABI, log)
+		return
+	}
+	name, params, err = guessEvent(log)
+	return name, params, name != "", err
+}
+
+func guessEvent(log models.EventLog) (string, any, error) {
+	if len(log.Topics) == 0 {
+		return "", nil, nil
+	}
+	data, err := hex.DecodeString(strings.TrimPrefix(log.Data, "0x"))
+	if err != nil {
+		return "", nil, err
+	}
+	topics := make([]ethcommon.Hash, 0, len(log.Topics))
+	for _, topic := range log.Topics {
+		topics = append(topics, ethcommon.HexToHash(topic))
+	}
+	sig, unpacked, err := contracts.Signatures.DecodeEvent(topics, data)
+	if err != nil || unpacked == nil {
+		return sig, nil, err
+	}
+	params, err := common.ParseToString(unpacked)
+	if err != nil {
+		return "", nil, err
+	}
+	return sig, params, nil
+}
+
+func decodeEventWithABI(contractABI *abi.ABI, log models.EventLog) (string, any, error) {
 	// Convert the hex-encoded data to bytes
 	trimmed := strings.TrimPrefix(log.Data, "0x")
 	data, err := hex.DecodeString(trimmed)
@@ -33,11 +71,6 @@ func DecodeEventDynamic(log models.EventLog) (string, any, error) {
 		return "", nil, err
 	}
 
-	contractABI, error := abi.JSON(strings.NewReader(relevantAbi))
-	if error != nil {
-		return "", nil, error
-	}
-
 	// Get the event for the topic
 
 	event, err := contractABI.EventByID(ethcommon.HexToHash(log.Topics[0]))
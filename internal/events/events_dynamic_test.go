@@ -117,3 +117,29 @@ func TestDecodeEventDynamicInvalidData(t *testing.T) {
 		assert.EqualError(t, err, "encoding/hex: invalid byte: U+007A 'z'")
 	}
 }
+
+func TestDecodeEventGuess(t *testing.T) {
+	transferLog := models.EventLog{
+		Address: "0xca67d0c50C4f5363aA47a11C54486aD8F28A7F2b",
+		Topics: []string{
+			"0xddf252ad1be2c89b69c2b068fc378daa952ba7f163c4a11628f55a4df523b3ef",
+			"0x0000000000000000000000000dc0d841f962759da25547c686fa440cf6c28c61",
+			"0x000000000000000000000000ed4d5f4f3641cbc056e466d15dbe2403e38056f8",
+		},
+		Data: "0x00000000000000000000000000000000000000000000000000000000000003e8",
+	}
+
+	name, params, guess, err := DecodeEvent(transferLog)
+	assert.NoError(t, err)
+	assert.True(t, guess)
+	assert.Equal(t, "Transfer(address,address,uint256)", name)
+	assert.Equal(t, []any{"0x0dc0d841f962759da25547c686fa440cf6c28c61", "0xed4d5f4f3641cbc056e466d15dbe2403e38056f8", "1000"}, params)
+
+	// contracts with ABI are decoded without guessing
+	transferLog.Address = "0x00000000000000000000000000000000000000fe"
+	transferLog.Topics[0] = "0x9310ccfcb8de723f578a9e4282ea9f521f05ae40dc08f3068dfad528a65ee3c7"
+	name, _, guess, err = DecodeEvent(transferLog)
+	assert.NoError(t, err)
+	assert.False(t, guess)
+	assert.Equal(t, "RewardsClaimed(address,address,uint256)", name)
+}
@@ -84,3 +84,16 @@ func TestDecodeRewardsTopics(t *testing.T) {
 		}
 	}
 }
+
+func TestCallArg(t *testing.T) {
+	call := &validatorCall{method: "setValidatorInfo", args: []interface{}{"0x1", "description", uint16(5)}}
+	if v, ok := callArg[string](call, 1); !ok || v != "description" {
+		t.Errorf("Expected description, got %v", v)
+	}
+	if _, ok := callArg[string](call, 2); ok {
+		t.Error("Expected type mismatch")
+	}
+	if _, ok := callArg[string](call, 3); ok {
+		t.Error("Expected missing argument")
+	}
+}
@@ -8,3 +8,28 @@ type LogReward struct {
 	Value     *big.Int
 	EventName string
 }
+
+type LogDelegation struct {
+	EventName        string
+	Delegator        string
+	Validator        string
+	ToValidator      string
+	UndelegationId   uint64
+	Value            *big.Int
+	TransactionHash  string
+	TransactionIndex uint64
+	LogIndex         uint64
+}
+
+// LogValidator is a validator lifecycle event. Fields that weren't changed or can't be decoded are nil
+type LogValidator struct {
+	EventName        string
+	Validator        string
+	Owner            *string
+	Commission       *uint64
+	Description      *string
+	Endpoint         *string
+	TransactionHash  string
+	TransactionIndex uint64
+	LogIndex         uint64
+}
@@ -0,0 +1,125 @@
+package events
+
+import (
+	"encoding/hex"
+	"fmt"
+	"strings"
+
+	"github.com/dailycrypto-me/daily-indexer/internal/common"
+	"github.com/dailycrypto-me/daily-indexer/internal/contracts"
+	"github.com/dailycrypto-me/daily-indexer/models"
+	ethcommon "github.com/ethereum/go-ethereum/common"
+	log "github.com/sirupsen/logrus"
+)
+
+const (
+	ValidatorRegisteredEvent = "ValidatorRegistered(address)"
+	ValidatorInfoSetEvent    = "ValidatorInfoSet(address)"
+	CommissionSetEvent       = "CommissionSet(address,uint16)"
+)
+
+var validatorTopics = map[string]bool{
+	ValidatorRegisteredEvent: true,
+	ValidatorInfoSetEvent:    true,
+	CommissionSetEvent:       true,
+}
+
+type validatorCall struct {
+	method    string
+	validator string
+	args      []interface{}
+}
+
+// decodeDposCall decodes input of DPOS contract call. Returns nil if the input isn't a DPOS call, e.g. it was made from other contract
+func decodeDposCall(input string) *validatorCall {
+	data, err := hex.DecodeString(strings.TrimPrefix(input, "0x"))
+	if err != nil || len(data) < 4 {
+		return nil
+	}
+	dposABI := contracts.Abis.Get(common.DposContractAddress)
+	method, err := dposABI.MethodById(data[:4])
+	if err != nil {
+		return nil
+	}
+	args, err := method.Inputs.Unpack(data[4:])
+	if err != nil || len(args) == 0 {
+		return nil
+	}
+	validator, ok := args[0].(ethcommon.Address)
+	if !ok {
+		return nil
+	}
+	return &validatorCall{method: method.Name, validator: strings.ToLower(validator.Hex()), args: args}
+}
+
+// callArg returns the call argument with the specified index. ok is false if there is no such argument or it has unexpected type, so the call isn't matching the ABI
+func callArg[T any](call *validatorCall, i int) (v T, ok bool) {
+	if i < len(call.args) {
+		v, ok = call.args[i].(T)
+	}
+	if !ok {
+		log.WithFields(log.Fields{"method": call.method, "validator": call.validator, "arg": i}).Error("Unexpected DPOS call argument, call is skipped")
+	}
+	return
+}
+
+// DecodeValidatorTopics decodes validator lifecycle events of DPOS contract. Owner, description, endpoint and commission that aren't included
+// in the events are taken from the transaction if it is a direct call of the DPOS contract
+func DecodeValidatorTopics(from, input string, logs []models.EventLog) (decodedEvents []LogValidator, err error) {
+	var call *validatorCall
+	for _, log := range logs {
+		if !strings.EqualFold(log.Address, common.DposContractAddress) {
+			continue
+		}
+		name, d, err := DecodeEventDynamic(log)
+		if err != nil {
+			return nil, err
+		}
+		if !validatorTopics[name] {
+			continue
+		}
+		event := LogValidator{
+			EventName:        name,
+			Validator:        strings.ToLower(ethcommon.HexToAddress(log.Topics[1]).Hex()),
+			TransactionHash:  log.TransactionHash,
+			TransactionIndex: log.TransactionIndex,
+			LogIndex:         log.LogIndex,
+		}
+		if call == nil {
+			call = decodeDposCall(input)
+		}
+		if call != nil && call.validator != event.Validator {
+			call = nil
+		}
+
+		switch name {
+		case CommissionSetEvent:
+			decoded := d.([]interface{})
+			commission := common.ParseUInt(fmt.Sprintf("%v", decoded[0]))
+			event.Commission = &commission
+		case ValidatorRegisteredEvent:
+			if call != nil && call.method == "registerValidator" {
+				commission, commissionOk := callArg[uint16](call, 3)
+				description, descriptionOk := callArg[string](call, 4)
+				endpoint, endpointOk := callArg[string](call, 5)
+				if !commissionOk || !descriptionOk || !endpointOk {
+					break
+				}
+				commission64 := uint64(commission)
+				owner := strings.ToLower(from)
+				event.Owner, event.Commission, event.Description, event.Endpoint = &owner, &commission64, &description, &endpoint
+			}
+		case ValidatorInfoSetEvent:
+			if call != nil && call.method == "setValidatorInfo" {
+				description, descriptionOk := callArg[string](call, 1)
+				endpoint, endpointOk := callArg[string](call, 2)
+				if !descriptionOk || !endpointOk {
+					break
+				}
+				event.Description, event.Endpoint = &description, &endpoint
+			}
+		}
+		decodedEvents = append(decodedEvents, event)
+	}
+	return decodedEvents, err
+}
@@ -3,6 +3,7 @@ package indexer
 import (
 	"fmt"
 	"math/big"
+	"strings"
 	"time"
 
 	"github.com/dailycrypto-me/daily-indexer/internal/chain"
@@ -22,7 +23,12 @@ type blockContext struct {
 	Block        *chain.BlockData
 	accounts     storage.Accounts
 	addressStats *storage.AddressStatsMap
+	methods      *storage.ContractMethodsMap
+	delegations  *storage.DelegationsMap
+	profiles     *storage.ValidatorProfilesMap
+	network      *storage.BlockNetworkStats
 	finalized    *storage.FinalizationData
+	weekStats    *storage.WeekStats
 }
 
 func MakeBlockContext(s storage.Storage, client chain.Client, config *common.Config) *blockContext {
@@ -32,6 +38,10 @@ func MakeBlockContext(s storage.Storage, client chain.Client, config *common.Con
 	bc.Config = config
 	bc.accounts = bc.Storage.GetAccounts()
 	bc.addressStats = storage.MakeAddressStatsMap()
+	bc.methods = storage.MakeContractMethodsMap()
+	bc.delegations = storage.MakeDelegationsMap()
+	bc.profiles = storage.MakeValidatorProfilesMap()
+	bc.network = storage.MakeBlockNetworkStats()
 	bc.finalized = s.GetFinalizationData()
 	bc.Client = client
 
@@ -45,6 +55,9 @@ func (bc *blockContext) SetBlockData(bd *chain.BlockData) {
 func (bc *blockContext) commit() {
 	bc.Batch.SetFinalizationData(bc.finalized)
 	bc.addressStats.AddToBatch(bc.Batch)
+	bc.methods.AddToBatch(bc.Batch)
+	bc.delegations.AddToBatch(bc.Batch)
+	bc.profiles.AddToBatch(bc.Batch)
 	bc.Batch.CommitBatch()
 
 	metrics.StorageCommitCounter.Inc()
@@ -57,9 +70,13 @@ func (bc *blockContext) process(bd *chain.BlockData, stats *chain.Stats) (dags_c
 	}
 	start_processing := time.Now()
 	bc.Block = bd
+	storage.MethodIndexMutex.Lock()
+	defer storage.MethodIndexMutex.Unlock()
 
 	tp := common.MakeThreadPool()
 	tp.Go(func() { bc.updateValidatorStats(bc.Block.Pbft) })
+	tp.Go(bc.updateVoteStats)
+	tp.Go(bc.updateValidatorSet)
 	tp.Go(common.MakeTaskWithoutParams(bc.processDags, &err).Run)
 	tp.Go(common.MakeTaskWithoutParams(bc.processTransactions, &err).Run)
 
@@ -72,6 +89,8 @@ func (bc *blockContext) process(bd *chain.BlockData, stats *chain.Stats) (dags_c
 
 	r := rewards.MakeRewards(bc.Storage, bc.Batch, bc.Config, bc.Block)
 	blockFee := r.Process(totalReward, bc.Block.Dags, bc.Block.Transactions, bc.Block.Votes, bc.Block.Pbft.Author)
+	bc.saveWeekStats(r)
+	storage.AddPeriodDags(bc.Storage, bc.Batch, bc.Block.Pbft.Timestamp, bc.getPeriodDags(), r.GetPeriodStats())
 
 	// add total fee to the dpos contract balance after the magnolia hardfork(it is added to block producers commission pools)
 	if bc.Config.Chain != nil && (bc.Block.Pbft.Number >= bc.Config.Chain.Hardforks.MagnoliaHf.BlockNum) {
@@ -81,12 +100,14 @@ func (bc *blockContext) process(bd *chain.BlockData, stats *chain.Stats) (dags_c
 	}
 
 	bc.accounts.AddToBalance(common.DposContractAddress, totalReward)
+	bc.saveSupplySnapshot(totalReward)
 
 	if bc.Block.Pbft.Number%1000 == 0 {
 		bc.checkIndexedBalances()
 	}
 
 	bc.Batch.SaveAccounts(bc.accounts)
+	bc.updateSupplyStats()
 
 	dags_count = uint64(len(bc.Block.Dags))
 	trx_count = uint64(len(bc.Block.Transactions))
@@ -99,6 +120,9 @@ func (bc *blockContext) process(bd *chain.BlockData, stats *chain.Stats) (dags_c
 	pbft_model := bc.Block.Pbft.GetModel()
 	bc.Batch.Add(pbft_model, bc.Block.Pbft.Author, pbft_author_index)
 	stats.AddPbft(pbft_model)
+	bc.network.AddToBatch(bc.Storage, bc.Batch, bc.Block.Pbft.Timestamp, dags_count)
+	bc.Batch.AddSingleKey(storage.ChainStatsSample{Number: bc.Block.Pbft.Number, Timestamp: bc.Block.Pbft.Timestamp, TrxCount: bc.finalized.TrxCount}, storage.FormatIntToKey(bc.Block.Pbft.Number))
+	bc.saveGasPriceStats()
 
 	bc.commit()
 	r.AfterCommit()
@@ -106,6 +130,38 @@ func (bc *blockContext) process(bd *chain.BlockData, stats *chain.Stats) (dags_c
 	return
 }
 
+// saveSupplySnapshot saves supply state at the end of the supply saving interval. Total supply in storage isn't updated with the block reward yet
+func (bc *blockContext) saveSupplySnapshot(blockReward *big.Int) {
+	interval := bc.Config.SupplySavingInterval
+	if interval == 0 || bc.Block.Pbft.Number%interval != 0 || bc.Block.TotalAmountDelegated == nil {
+		return
+	}
+	totalSupply := big.NewInt(0).Add(bc.Storage.GetTotalSupply(), blockReward)
+	snapshot := storage.MakeSupplySnapshot(bc.Block.Pbft.Number, bc.Block.Pbft.Timestamp, totalSupply, bc.Block.TotalAmountDelegated, storage.GetPreviousSupply(bc.Storage))
+	bc.Batch.AddSingleKey(snapshot, storage.FormatIntToKey(snapshot.Block))
+}
+
+func (bc *blockContext) updateSupplyStats() {
+	supply := bc.Storage.GetSupplyStats()
+	supply.Block = bc.Block.Pbft.Number
+	if bc.Block.TotalAmountDelegated != nil {
+		supply.TotalDelegated = bc.Block.TotalAmountDelegated
+	}
+	bc.Batch.SetSupplyStats(&supply)
+}
+
+func (bc *blockContext) saveGasPriceStats() {
+	prices := make([]uint64, 0, len(bc.Block.Transactions))
+	gasUsed := uint64(0)
+	for _, tx := range bc.Block.Transactions {
+		prices = append(prices, uint64(tx.GasPrice))
+		gasUsed += uint64(tx.GasUsed)
+	}
+	if stats := storage.MakeGasPriceStats(bc.Block.Pbft.Number, bc.Block.Pbft.Timestamp, prices, gasUsed); stats != nil {
+		bc.Batch.AddSingleKey(stats, storage.FormatIntToKey(stats.Period))
+	}
+}
+
 func (bc *blockContext) checkIndexedBalances() {
 	if len(bc.accounts) == 0 {
 		log.Fatal("checkIndexedBalances: No balances in the storage, something is wrong")
@@ -132,17 +188,121 @@ func (bc *blockContext) checkIndexedBalances() {
 func (bc *blockContext) updateValidatorStats(block *chain.Block) {
 	tn, _ := goment.Unix(int64(block.Timestamp))
 	weekStats := bc.Storage.GetWeekStats(int32(tn.ISOWeekYear()), int32(tn.ISOWeek()))
+	if weekStats.Total == 0 {
+		bc.finalizePreviousWeek(block)
+	}
 	weekStats.AddPbftBlock(block.GetModel())
-	bc.Batch.UpdateWeekStats(weekStats)
+	// week stats are saved after rewards processing with the period metrics
+	bc.weekStats = &weekStats
+}
+
+// getDagsCounts returns number of DAG blocks produced by validators in the period
+func (bc *blockContext) getDagsCounts() map[string]uint64 {
+	dags := make(map[string]uint64)
+	for _, dag := range bc.Block.Dags {
+		dags[dag.Sender]++
+	}
+	return dags
+}
+
+// saveWeekStats adds DAG blocks, votes and rewards of the period to the week stats and saves them
+func (bc *blockContext) saveWeekStats(r *rewards.Rewards) {
+	stakes := make(map[string]*big.Int, len(bc.Block.Validators))
+	for _, validator := range bc.Block.Validators {
+		stakes[validator.Address] = validator.TotalStake
+	}
+	bc.weekStats.AddPeriodMetrics(bc.Block.Pbft.Author, bc.getDagsCounts(), r.GetPeriodStats(), r.GetDistributedRewards(), stakes)
+	bc.Batch.UpdateWeekStats(*bc.weekStats)
+}
+
+// updateVoteStats adds cert votes of the period to the validators participation stats. Periods without votes aren't counted
+func (bc *blockContext) updateVoteStats() {
+	if len(bc.Block.Votes.Votes) == 0 {
+		return
+	}
+	eligible := make([]string, 0)
+	for _, validator := range bc.getPreviousPeriodValidators() {
+		if bc.Config.IsEligible(validator.Stake) {
+			eligible = append(eligible, validator.Address)
+		}
+	}
+	votes := make(map[string]uint64, len(bc.Block.Votes.Votes))
+	for _, vote := range bc.Block.Votes.Votes {
+		votes[strings.ToLower(vote.Voter)] = common.ParseUInt(vote.Weight)
+	}
+	storage.AddPeriodVotes(bc.Storage, bc.Batch, bc.Block.Pbft.Timestamp, eligible, votes)
+}
+
+// getPreviousPeriodValidators returns validators of the period certified by the block votes. The last saved set is the set of the previous period
+// as the set of this period is saved with the block batch. Validators of this period are used if there is no saved set yet
+func (bc *blockContext) getPreviousPeriodValidators() []storage.ValidatorStake {
+	if set := bc.Storage.GetLastValidatorSet(); len(set.Validators) > 0 {
+		return set.Validators
+	}
+	ret := make([]storage.ValidatorStake, 0, len(bc.Block.Validators))
+	for _, validator := range bc.Block.Validators {
+		ret = append(ret, storage.ValidatorStake{Address: validator.Address, Stake: validator.TotalStake})
+	}
+	return ret
+}
+
+func (bc *blockContext) updateValidatorSet() {
+	stakes := make(map[string]*big.Int, len(bc.Block.Validators))
+	for _, validator := range bc.Block.Validators {
+		stakes[validator.Address] = validator.TotalStake
+	}
+	storage.UpdateValidatorSet(bc.Storage, bc.Batch, bc.Block.Pbft.Number, bc.Block.Pbft.Timestamp, stakes)
+}
+
+// finalizePreviousWeek adds stats of the previous week to the index by validator when the first block of the new week is processed
+func (bc *blockContext) finalizePreviousWeek(block *chain.Block) {
+	prev, _ := goment.Unix(int64(block.Timestamp))
+	prev.Subtract(1, "week")
+	year, week := int32(prev.ISOWeekYear()), int32(prev.ISOWeek())
+	weekStats := bc.Storage.GetWeekStats(year, week)
+	if weekStats.Total == 0 {
+		return
+	}
+
+	var getYield func(address string) string
+	if interval := bc.Config.ValidatorsYieldSavingInterval; interval != 0 {
+		yieldBlock := common.GetYieldIntervalEnd(block.Number-1, nil, interval)
+		getYield = func(address string) string {
+			return bc.Storage.GetValidatorYield(address, yieldBlock).Yield
+		}
+	}
+	storage.AddWeekToValidatorsIndex(bc.Batch, &weekStats, year, week, getYield)
+
+	set := storage.GetValidatorSet(bc.Storage, block.Number-1)
+	bc.Batch.AddSingleKey(storage.WeekValidatorSet(set), storage.FormatIntToKey(storage.FormatWeek(year, week)))
 }
 
 func (bc *blockContext) processDags() (err error) {
+	nodes := make([]storage.DagNode, 0, len(bc.Block.Dags))
 	for _, dag := range bc.Block.Dags {
 		bc.saveDag(&dag)
+		nodes = append(nodes, storage.DagNode{Hash: dag.Hash, Sender: strings.ToLower(dag.Sender), Level: dag.Level, Timestamp: dag.Timestamp, TransactionCount: dag.TransactionCount, Pivot: dag.Pivot, Tips: dag.Tips})
 	}
+	bc.Batch.AddSingleKey(storage.MakeDagGraph(bc.Block.Pbft.Number, nodes), storage.FormatIntToKey(bc.Block.Pbft.Number))
 	return
 }
 
+func (bc *blockContext) getPeriodDags() []storage.PeriodDag {
+	dags := make([]storage.PeriodDag, 0, len(bc.Block.Dags))
+	for _, dag := range bc.Block.Dags {
+		dags = append(dags, storage.PeriodDag{Sender: dag.Sender, Timestamp: dag.Timestamp, Difficulty: dag.Vdf.Difficulty, Transactions: dag.Transactions})
+	}
+	return dags
+}
+
+func (bc *blockContext) getPeriodTransactions() []string {
+	transactions := make([]string, 0, len(bc.Block.Transactions))
+	for _, trx := range bc.Block.Transactions {
+		transactions = append(transactions, trx.Hash)
+	}
+	return transactions
+}
+
 func (bc *blockContext) saveDag(dag *chain.DagBlock) {
 	log.WithFields(log.Fields{"sender": dag.Sender, "hash": dag.Hash}).Trace("Saving DAG block")
 	dag_index := bc.addressStats.GetAddress(bc.Storage, dag.Sender).AddDag(dag.GetModel().Timestamp)
@@ -47,11 +47,16 @@ func (g *Genesis) process() {
 		genesisSupply.Add(genesisSupply, value)
 		accounts.AddToBalance(trx.To, value)
 	}
+	supply := storage.MakeSupplyStats()
+	supply.GenesisSupply.Set(genesisSupply)
 	for _, validator := range g.genesis.Dpos.InitialValidators {
+		g.bc.profiles.AddProfile(g.storage, validator.ToValidatorEvent(), 0, g.genesis.DagGenesisBlock.Timestamp)
 		for addr, value := range validator.Delegations {
 			delegation := common.ParseStringToBigInt(value)
+			supply.TotalDelegated.Add(supply.TotalDelegated, delegation)
 			accounts.AddToBalance(addr, big.NewInt(0).Neg(delegation))
 			accounts.AddToBalance(common.DposContractAddress, delegation)
+			g.bc.delegations.AddDelegation(g.storage, addr, validator.Address, delegation, 0)
 		}
 	}
 	log.WithField("count", len(g.genesis.InitialBalances)).Info("Genesis: Init balance transactions parsed")
@@ -61,5 +66,6 @@ func (g *Genesis) process() {
 	g.bc.finalized.TrxCount = 0
 	g.bc.Batch.SetGenesisHash(storage.GenesisHash(g.hash))
 	g.bc.Batch.SetTotalSupply(genesisSupply)
+	g.bc.Batch.SetSupplyStats(&supply)
 	g.bc.commit()
 }
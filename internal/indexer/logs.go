@@ -5,6 +5,7 @@ import (
 
 	"github.com/dailycrypto-me/daily-indexer/internal/chain"
 	comm "github.com/dailycrypto-me/daily-indexer/internal/common"
+	"github.com/dailycrypto-me/daily-indexer/internal/storage"
 	"github.com/dailycrypto-me/daily-indexer/models"
 	"github.com/ethereum/go-ethereum/common"
 )
@@ -18,10 +19,19 @@ func (bc *blockContext) processTransactionLogs(tx chain.Transaction) (err error)
 		Data: logs,
 	}
 	bc.Batch.AddSingleKey(logsResponse, tx.Hash)
+	storage.AddLogsToIndexes(bc.Batch, bc.Block.Pbft.Number, bc.Block.Pbft.Timestamp, logs)
 	err = bc.accounts.UpdateEvents(logs)
 	if err != nil {
 		return err
 	}
+	err = bc.delegations.UpdateEvents(bc.Storage, bc.Block.Pbft.Number, bc.Block.Pbft.Timestamp, logs)
+	if err != nil {
+		return err
+	}
+	err = bc.profiles.UpdateEvents(bc.Storage, bc.Block.Pbft.Number, bc.Block.Pbft.Timestamp, tx.From, tx.Input, logs)
+	if err != nil {
+		return err
+	}
 	err = bc.handleValidatorRegistrations(logs)
 	if err != nil {
 		return err
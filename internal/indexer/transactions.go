@@ -5,7 +5,10 @@ import (
 
 	"github.com/dailycrypto-me/daily-indexer/internal/chain"
 	"github.com/dailycrypto-me/daily-indexer/internal/common"
+	"github.com/dailycrypto-me/daily-indexer/internal/metrics"
 	"github.com/dailycrypto-me/daily-indexer/internal/storage"
+	"github.com/dailycrypto-me/daily-indexer/internal/transaction"
+	"github.com/dailycrypto-me/daily-indexer/models"
 	"github.com/ethereum/go-ethereum/rlp"
 	log "github.com/sirupsen/logrus"
 )
@@ -18,19 +21,37 @@ func (bc *blockContext) processTransactions() (err error) {
 	if len(bc.Block.Pbft.Transactions) != len(bc.Block.Transactions) {
 		log.WithFields(log.Fields{"in_block": len(bc.Block.Pbft.Transactions), "transactions": len(bc.Block.Transactions), "traces": len(bc.Block.Traces)}).Error("Transactions count mismatch")
 	}
+	inclusions := storage.GetTransactionsInclusion(bc.Block.Pbft.Timestamp, bc.getPeriodDags(), bc.getPeriodTransactions())
+	storage.AddPeriodInclusions(bc.Storage, bc.Batch, bc.Block.Pbft.Number, bc.Block.Pbft.Timestamp, inclusions)
+	metrics.SaveInclusions(inclusions)
+
 	feeReward := big.NewInt(0)
 	for t_idx := 0; t_idx < len(bc.Block.Transactions); t_idx++ {
 		bc.Block.Transactions[t_idx].SetTimestamp(bc.Block.Pbft.Timestamp)
 
-		bc.SaveTransaction(bc.Block.Transactions[t_idx].GetStorage(), false)
+		trx := bc.Block.Transactions[t_idx].GetStorage()
+		if inclusion, ok := inclusions[trx.Hash]; ok {
+			trx.InclusionLatency, trx.DagInclusions = inclusion.Latency, inclusion.DagsCount
+		}
+		if len(bc.Block.Traces) > 0 && len(bc.Block.Traces[t_idx].Trace) > 0 {
+			// trace is saved for the failed transactions as well to show where it was reverted
+			bc.Batch.AddSingleKey(makeCallTrace(bc.Block.Traces[t_idx]), trx.Hash)
+			if !trx.Status {
+				trx.RevertReason = getRevertReason(bc.Block.Traces[t_idx])
+			}
+		}
+		transaction.DecodeMethod(&trx)
+		bc.SaveTransaction(trx, false)
 
 		trx_fee := bc.Block.Transactions[t_idx].GetFee()
 		feeReward.Add(feeReward, trx_fee)
 		// Remove fee from sender balance
 		bc.accounts.AddToBalance(bc.Block.Transactions[t_idx].From, big.NewInt(0).Neg(trx_fee))
 		if !bc.Block.Transactions[t_idx].Status {
+			bc.network.AddTransaction(bc.Block.Transactions[t_idx].From, trx_fee, nil)
 			continue
 		}
+		bc.network.AddTransaction(bc.Block.Transactions[t_idx].From, trx_fee, bc.Block.Transactions[t_idx].Value)
 		// remove value from sender and add to receiver
 		receiver := bc.Block.Transactions[t_idx].To
 		// handle contract creation
@@ -69,6 +90,7 @@ func (bc *blockContext) processInternalTransactions(trace chain.TransactionTrace
 			continue
 		}
 		internal := makeInternal(bc.Block.Transactions[t_idx].GetStorage(), entry, gasPrice)
+		transaction.DecodeMethod(&internal)
 		internal_transactions.Data = append(internal_transactions.Data, internal)
 
 		bc.SaveTransaction(internal, true)
@@ -79,13 +101,47 @@ func (bc *blockContext) processInternalTransactions(trace chain.TransactionTrace
 	return
 }
 
+func makeCallTrace(trace chain.TransactionTrace) *storage.CallTrace {
+	ret := &storage.CallTrace{Calls: make([]storage.TraceCall, 0, len(trace.Trace))}
+	for _, entry := range trace.Trace {
+		ret.Calls = append(ret.Calls, storage.TraceCall{
+			TraceAddress: entry.TraceAddress,
+			Type:         entry.Type,
+			CallType:     entry.Action.CallType,
+			From:         entry.Action.From,
+			To:           chain.GetInternalTransactionTarget(entry),
+			Value:        common.ParseStringToBigInt(entry.Action.Value),
+			Gas:          common.ParseUInt(entry.Action.Gas),
+			GasUsed:      common.ParseUInt(entry.Result.GasUsed),
+			Input:        entry.Action.Input,
+			Output:       entry.Result.Output,
+		})
+	}
+	return ret
+}
+
+// getRevertReason decodes output of the transaction call. Contracts of all trace calls are passed as the error could be raised by the nested call
+func getRevertReason(trace chain.TransactionTrace) string {
+	addresses := make([]string, 0, len(trace.Trace))
+	for _, entry := range trace.Trace {
+		addresses = append(addresses, chain.GetInternalTransactionTarget(entry))
+	}
+	root := trace.Trace[0]
+	return transaction.DecodeRevertReason(root.Result.Output, root.Error, addresses)
+}
+
 func makeInternal(trx storage.Transaction, entry chain.TraceEntry, gasPrice uint64) (internal storage.Transaction) {
 	internal = trx
 	internal.From = entry.Action.From
 	internal.To = chain.GetInternalTransactionTarget(entry)
 	internal.Value = common.ParseStringToBigInt(entry.Action.Value)
-	internal.GasCost = chain.GetTransactionFee(common.ParseUInt(entry.Result.GasUsed), gasPrice)
+	internal.GasUsed = common.ParseUInt(entry.Result.GasUsed)
+	internal.GasCost = chain.GetTransactionFee(internal.GasUsed, gasPrice)
 	internal.Type = chain.GetTransactionType(trx.To, entry.Action.Input, entry.Type, true)
+	internal.ContractAddress = ""
+	if internal.Type == models.InternalContractCreation {
+		internal.ContractAddress = internal.To
+	}
 	internal.BlockNumber = trx.BlockNumber
 	return
 }
@@ -98,11 +154,23 @@ func (bc *blockContext) SaveTransaction(trx storage.Transaction, internal bool)
 	if err != nil {
 		log.WithFields(log.Fields{"from": trx.From, "to": trx.To, "hash": trx.Hash}).Error("Failed to encode transaction")
 	}
+	// address is new on its first transaction of any kind, so contract-created addresses and recipients of internal transfers are counted too
 	from_index := bc.addressStats.GetAddress(bc.Storage, trx.From).AddTransaction(trx.Timestamp)
 	bc.Batch.AddSerialized(trx, trx_bytes, trx.From, from_index)
+	if from_index == 1 {
+		bc.network.AddNewAddress()
+	}
 	if trx.To != "" {
 		to_index := bc.addressStats.GetAddress(bc.Storage, trx.To).AddTransaction(trx.Timestamp)
 		bc.Batch.AddSerialized(trx, trx_bytes, trx.To, to_index)
+		if to_index == 1 {
+			bc.network.AddNewAddress()
+		}
+	}
+
+	if trx.Method != "" {
+		method_index := bc.methods.AddCall(bc.Storage, trx.To, trx.Method)
+		bc.Batch.AddSerialized(storage.MethodTransaction(trx), trx_bytes, storage.GetMethodKey(trx.To, trx.Method), method_index)
 	}
 
 	if !internal {
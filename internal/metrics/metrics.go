@@ -64,6 +64,16 @@ var (
 		Name: metricPrefix + "_dags_indexed_total",
 		Help: "Total number of indexed DAG blocks",
 	})
+	TransactionInclusionLatency = promauto.NewHistogram(prometheus.HistogramOpts{
+		Name:    metricPrefix + "_transaction_inclusion_latency_seconds",
+		Help:    "Time from the first DAG block that included the transaction to the PBFT block finalization",
+		Buckets: []float64{1, 2, 3, 5, 10, 20, 30, 60, 120},
+	})
+	TransactionDagInclusions = promauto.NewHistogram(prometheus.HistogramOpts{
+		Name:    metricPrefix + "_transaction_dag_inclusions",
+		Help:    "Number of DAG blocks of the period that included the transaction",
+		Buckets: []float64{1, 2, 3, 5, 10},
+	})
 )
 
 func RunPrometheusServer(listenAddr string) {
@@ -74,6 +84,14 @@ func RunPrometheusServer(listenAddr string) {
 	}
 }
 
+// SaveInclusions observes inclusion latency and DAG inclusions of the period transactions
+func SaveInclusions(inclusions map[string]storage.TransactionInclusion) {
+	for _, inclusion := range inclusions {
+		TransactionInclusionLatency.Observe(float64(inclusion.Latency))
+		TransactionDagInclusions.Observe(float64(inclusion.DagsCount))
+	}
+}
+
 func Save(start_processing time.Time, dags_count, trx_count uint64, finalized *storage.FinalizationData) {
 	BlockProcessingTimeMilisec.Observe(float64(time.Since(start_processing).Milliseconds()))
 	IndexedBlocksCounter.Inc()
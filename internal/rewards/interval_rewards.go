@@ -11,17 +11,27 @@ import (
 
 type IntervalRewards struct {
 	ValidatorRewards map[string]*big.Int
-	TotalReward      *big.Int
-	BlockFee         *big.Int
+	// Breakdown is the rewards of validators split by categories. Unlike ValidatorRewards it also includes fees
+	Breakdown   map[string]*storage.ValidatorRewards
+	TotalReward *big.Int
+	BlockFee    *big.Int
 }
 
 func makeIntervalRewards() (r IntervalRewards) {
 	r.ValidatorRewards = make(map[string]*big.Int)
+	r.Breakdown = make(map[string]*storage.ValidatorRewards)
 	r.TotalReward = big.NewInt(0)
 	r.BlockFee = big.NewInt(0)
 	return
 }
 
+func (ir *IntervalRewards) getBreakdown(validator string) *storage.ValidatorRewards {
+	if ir.Breakdown[validator] == nil {
+		ir.Breakdown[validator] = storage.MakeValidatorRewards(validator)
+	}
+	return ir.Breakdown[validator]
+}
+
 func (r *Rewards) accumulateRewards(stats *storage.RewardsStats, intervalRewards *IntervalRewards) {
 	pr := r.rewardsFromStats(stats)
 	if r.config.Chain.Hardforks.IsAspenHfTwo(r.blockNum) {
@@ -33,6 +43,9 @@ func (r *Rewards) accumulateRewards(stats *storage.RewardsStats, intervalRewards
 		}
 		intervalRewards.ValidatorRewards[validator].Add(intervalRewards.ValidatorRewards[validator], reward)
 	}
+	for validator, breakdown := range pr.Breakdown {
+		intervalRewards.getBreakdown(validator).Add(breakdown)
+	}
 	intervalRewards.TotalReward.Add(intervalRewards.TotalReward, pr.TotalReward)
 	intervalRewards.BlockFee.Add(intervalRewards.BlockFee, pr.BlockFee)
 }
@@ -18,10 +18,13 @@ type Rewards struct {
 	totalSupply *big.Int
 
 	blockNum uint64
+	// periodStats and breakdown are the stats and distributed rewards of the last processed period
+	periodStats *storage.RewardsStats
+	breakdown   map[string]*storage.ValidatorRewards
 }
 
 func MakeRewards(storage storage.Storage, batch storage.Batch, config *common.Config, block *chain.BlockData) *Rewards {
-	r := Rewards{storage, batch, config, MakeValidators(config, block.Validators), block.TotalAmountDelegated, block.TotalSupply, block.Pbft.Number}
+	r := Rewards{storage: storage, batch: batch, config: config, validators: MakeValidators(config, block.Validators), totalStake: block.TotalAmountDelegated, totalSupply: block.TotalSupply, blockNum: block.Pbft.Number}
 	// special case for  the networks without aspen hf part1 (incorrect initialization of the supply without aspen hf part1)
 	if r.totalSupply.Sign() == 0 {
 		r.totalSupply = r.storage.GetTotalSupply()
@@ -42,6 +45,7 @@ func (r *Rewards) Process(totalMinted *big.Int, dags []chain.DagBlock, trxs []ch
 		log.WithFields(log.Fields{"total_stake": r.totalStake}).Info("totalStake")
 	}
 	rewardsStats := r.makeRewardsStats(dags, votes, trxs, blockAuthor)
+	r.periodStats = rewardsStats
 	totalReward, currentBlockFee := r.ProcessStats(rewardsStats, totalMinted)
 
 	if totalReward.Cmp(totalMinted) != 0 {
@@ -70,12 +74,25 @@ func (r *Rewards) ProcessStats(periodStats *storage.RewardsStats, totalMinted *b
 		periodRewards = r.rewardsFromStats(periodStats)
 	}
 
+	r.breakdown = periodRewards.Breakdown
+
 	validators_yield := GetValidatorsYield(periodRewards.ValidatorRewards, r.validators)
 	r.batch.AddSingleKey(storage.ValidatorsYield{Yields: validators_yield}, storage.FormatIntToKey(r.blockNum))
 	r.batch.AddSingleKey(storage.MultipliedYield{Yield: GetMultipliedYield(totalMinted, r.totalStake)}, storage.FormatIntToKey(r.blockNum))
+	storage.AddPeriodRewards(r.batch, r.blockNum, periodRewards.TotalReward, periodRewards.BlockFee, periodRewards.Breakdown)
 	return periodRewards.TotalReward, periodRewards.BlockFee
 }
 
+// GetPeriodStats returns rewardable DAG blocks count and votes weight of validators in the processed period
+func (r *Rewards) GetPeriodStats() *storage.RewardsStats {
+	return r.periodStats
+}
+
+// GetDistributedRewards returns rewards of validators distributed in the processed period. It is empty if rewards weren't distributed in this period
+func (r *Rewards) GetDistributedRewards() map[string]*storage.ValidatorRewards {
+	return r.breakdown
+}
+
 func (r *Rewards) makeRewardsStats(
 	dags []chain.DagBlock, votes chain.VotesResponse,
 	trxs []chain.Transaction, block_author string) *storage.RewardsStats {
@@ -114,6 +131,7 @@ func (r *Rewards) rewardsFromStats(stats *storage.RewardsStats) (rewards Interva
 		if rewards.ValidatorRewards[addr] == nil {
 			rewards.ValidatorRewards[addr] = big.NewInt(0)
 		}
+		breakdown := rewards.getBreakdown(addr)
 
 		// Add dags reward
 		if s.DagBlocksCount > 0 {
@@ -122,6 +140,7 @@ func (r *Rewards) rewardsFromStats(stats *storage.RewardsStats) (rewards Interva
 			dag_reward.Div(dag_reward, big.NewInt(0).SetUint64(stats.TotalDagCount))
 			rewards.TotalReward.Add(rewards.TotalReward, dag_reward)
 			rewards.ValidatorRewards[addr].Add(rewards.ValidatorRewards[addr], dag_reward)
+			breakdown.Dags.Add(breakdown.Dags, dag_reward)
 		}
 
 		// Add voting reward
@@ -131,10 +150,12 @@ func (r *Rewards) rewardsFromStats(stats *storage.RewardsStats) (rewards Interva
 			vote_reward.Div(vote_reward, big.NewInt(0).SetUint64(stats.TotalVotesWeight))
 			rewards.TotalReward.Add(rewards.TotalReward, vote_reward)
 			rewards.ValidatorRewards[addr].Add(rewards.ValidatorRewards[addr], vote_reward)
+			breakdown.Votes.Add(breakdown.Votes, vote_reward)
 		}
 
 		if s.FeeReward != nil && s.FeeReward.Cmp(big.NewInt(0)) > 0 {
 			rewards.BlockFee.Add(rewards.BlockFee, s.FeeReward)
+			breakdown.Fees.Add(breakdown.Fees, s.FeeReward)
 		}
 	}
 	blockAuthorReward := big.NewInt(0)
@@ -160,6 +181,8 @@ func (r *Rewards) rewardsFromStats(stats *storage.RewardsStats) (rewards Interva
 		}
 		rewards.TotalReward.Add(rewards.TotalReward, blockAuthorReward)
 		rewards.ValidatorRewards[stats.BlockAuthor].Add(rewards.ValidatorRewards[stats.BlockAuthor], blockAuthorReward)
+		authorBreakdown := rewards.getBreakdown(stats.BlockAuthor)
+		authorBreakdown.Bonus.Add(authorBreakdown.Bonus, blockAuthorReward)
 	}
 	return
 }
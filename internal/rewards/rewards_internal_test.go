@@ -428,3 +428,54 @@ func TestValidatorsYieldSaving(t *testing.T) {
 	yield := st.GetTotalYield(10)
 	assert.Equal(t, common.FormatFloat(10*GetYieldForInterval(multiplied_yield, config.Chain.BlocksPerYear, int64(config.TotalYieldSavingInterval))), yield.Yield)
 }
+
+func TestRewardsBreakdown(t *testing.T) {
+	config := makeTestConfig()
+	validator1_addr := strings.ToLower(ce.HexToAddress("0x1").Hex())
+	validator2_addr := strings.ToLower(ce.HexToAddress("0x2").Hex())
+	validator3_addr := strings.ToLower(ce.HexToAddress("0x3").Hex())
+
+	validators_list := []chain.Validator{
+		{Address: validator1_addr, TotalStake: big.NewInt(5000000)},
+		{Address: validator2_addr, TotalStake: big.NewInt(5000000)},
+		{Address: validator3_addr, TotalStake: big.NewInt(5000000)},
+	}
+
+	st := pebble.NewStorage("")
+	block := chain.Block{Pbft: models.Pbft{Number: 1, Author: validator3_addr}}
+	bd := &chain.BlockData{Pbft: &block, TotalAmountDelegated: big.NewInt(5000000 * 3), TotalSupply: big.NewInt(1), Validators: validators_list}
+	batch := st.NewBatch()
+	r := MakeRewards(st, batch, config, bd)
+	r.totalStake = big.NewInt(1000000000000)
+
+	dags := makeDags(AddressCount{validator1_addr: 1, validator2_addr: 2})
+	votes := makeVotes(AddressCount{validator1_addr: 1, validator2_addr: 2, validator3_addr: 2})
+	stats := r.makeRewardsStats(dags, votes, makeTransactions(3), block.Author)
+
+	rewards := r.rewardsFromStats(stats)
+	for addr, reward := range rewards.ValidatorRewards {
+		breakdown := rewards.Breakdown[addr]
+		minted := big.NewInt(0).Add(breakdown.Dags, breakdown.Votes)
+		minted.Add(minted, breakdown.Bonus)
+		assert.Equal(t, reward, minted)
+	}
+	assert.Equal(t, 0, rewards.Breakdown[validator3_addr].Dags.Sign())
+	assert.Equal(t, 1, rewards.Breakdown[validator3_addr].Bonus.Sign())
+	assert.Equal(t, 0, rewards.Breakdown[validator1_addr].Bonus.Sign())
+
+	totalReward, _ := r.ProcessStats(stats, rewards.TotalReward)
+	batch.CommitBatch()
+
+	periodRewards := st.GetPeriodRewards(1)
+	assert.NotNil(t, periodRewards)
+	assert.Equal(t, totalReward, periodRewards.TotalReward)
+	assert.Len(t, periodRewards.Validators, 3)
+	assert.Nil(t, st.GetPeriodRewards(2))
+
+	validatorRewards, count := storage.GetValidatorRewards(st, validator2_addr, 0, 10)
+	assert.Equal(t, uint64(1), count)
+	assert.Equal(t, big.NewInt(0).Add(rewards.ValidatorRewards[validator2_addr], validatorRewards.Fees), validatorRewards.Total())
+	assert.Equal(t, 1, validatorRewards.Fees.Sign())
+	_, count = storage.GetValidatorRewards(st, validator2_addr, 2, 10)
+	assert.Equal(t, uint64(0), count)
+}
@@ -3,6 +3,7 @@ package storage
 type Batch interface {
 	CommitBatch()
 	SetTotalSupply(s *TotalSupply)
+	SetSupplyStats(s *SupplyStats)
 	SetFinalizationData(f *FinalizationData)
 	SetGenesisHash(h GenesisHash)
 	UpdateWeekStats(w WeekStats)
@@ -14,4 +15,5 @@ type Batch interface {
 	AddWithKey(o interface{}, key []byte) error
 	AddSerializedWithKey(o interface{}, data, key []byte) error
 	Remove(key []byte)
+	RemoveSingleKey(o interface{}, key string)
 }
@@ -0,0 +1,64 @@
+package storage
+
+import (
+	"math/big"
+
+	"github.com/dailycrypto-me/daily-indexer/models"
+)
+
+// TraceCall is the call of the transaction trace. TraceAddress is the path of the call in the calls tree, it is empty for the transaction itself
+type TraceCall struct {
+	TraceAddress []uint16
+	Type         string
+	CallType     string
+	From         string
+	To           string
+	Value        *big.Int
+	Gas          uint64
+	GasUsed      uint64
+	Input        string
+	Output       string
+}
+
+// CallTrace is the calls of the transaction in the trace order. It is saved with the transaction hash key
+type CallTrace struct {
+	Calls []TraceCall
+}
+
+func (c *TraceCall) toModel() models.TraceCall {
+	callType := c.Type
+	// call type distinguishes call, delegatecall and staticcall
+	if c.Type == "call" && c.CallType != "" {
+		callType = c.CallType
+	}
+	return models.TraceCall{
+		Type:    callType,
+		From:    c.From,
+		To:      c.To,
+		Value:   c.Value.String(),
+		Gas:     c.Gas,
+		GasUsed: c.GasUsed,
+		Input:   c.Input,
+		Output:  c.Output,
+		Calls:   make([]models.TraceCall, 0),
+	}
+}
+
+// ToModel returns the calls tree of the transaction. Calls with the address that doesn't match the tree are added to the deepest existing parent
+func (t *CallTrace) ToModel() *models.TraceCall {
+	if len(t.Calls) == 0 {
+		return nil
+	}
+	root := t.Calls[0].toModel()
+	for _, call := range t.Calls[1:] {
+		parent := &root
+		for _, idx := range call.TraceAddress[:max(len(call.TraceAddress)-1, 0)] {
+			if int(idx) >= len(parent.Calls) {
+				break
+			}
+			parent = &parent.Calls[idx]
+		}
+		parent.Calls = append(parent.Calls, call.toModel())
+	}
+	return &root
+}
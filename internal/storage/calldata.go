@@ -0,0 +1,39 @@
+package storage
+
+import (
+	"encoding/json"
+	"io"
+
+	"github.com/dailycrypto-me/daily-indexer/models"
+	"github.com/ethereum/go-ethereum/rlp"
+)
+
+// Calldata is the input of the contract call decoded on indexing. It is saved with the transaction, so it isn't decoded on every request
+type Calldata models.CallData
+
+// storedCalldata is the RLP representation of the Calldata. Params are saved as JSON because RLP doesn't keep types of the decoded values
+type storedCalldata struct {
+	Name   string
+	Params string
+	Guess  bool
+}
+
+func (c *Calldata) EncodeRLP(w io.Writer) error {
+	params, err := json.Marshal(c.Params)
+	if err != nil {
+		return err
+	}
+	return rlp.Encode(w, storedCalldata{Name: c.Name, Params: string(params), Guess: c.Guess != nil && *c.Guess})
+}
+
+func (c *Calldata) DecodeRLP(s *rlp.Stream) error {
+	var stored storedCalldata
+	if err := s.Decode(&stored); err != nil {
+		return err
+	}
+	c.Name, c.Params, c.Guess = stored.Name, nil, nil
+	if stored.Guess {
+		c.Guess = &stored.Guess
+	}
+	return json.Unmarshal([]byte(stored.Params), &c.Params)
+}
@@ -0,0 +1,122 @@
+package storage
+
+import (
+	"sort"
+
+	"github.com/dailycrypto-me/daily-indexer/models"
+	"github.com/ethereum/go-ethereum/rlp"
+	log "github.com/sirupsen/logrus"
+)
+
+// ChainStatsSample is saved for every block. Stats for any window are calculated from the samples of the window edges
+type ChainStatsSample struct {
+	Number    uint64
+	Timestamp uint64
+	// TrxCount is the total number of transactions executed up to this block
+	TrxCount uint64
+}
+
+// ChainStatsWindow is the range chain stats are calculated for. Either Blocks or Duration(in seconds) is set
+type ChainStatsWindow struct {
+	Blocks   uint64
+	Duration uint64
+}
+
+var ChainStatsWindows = map[string]ChainStatsWindow{
+	"100blocks": {Blocks: 100},
+	"1h":        {Duration: 60 * 60},
+	"24h":       {Duration: 24 * 60 * 60},
+	"7d":        {Duration: 7 * 24 * 60 * 60},
+}
+
+func getFirstChainStatsSample(s Storage) (first *ChainStatsSample) {
+	s.ForEach(new(ChainStatsSample), "", nil, func(_, res []byte) (stop bool) {
+		first = new(ChainStatsSample)
+		err := rlp.DecodeBytes(res, first)
+		if err != nil {
+			log.WithError(err).Fatal("Error decoding chain stats sample from db")
+		}
+		return true
+	})
+	return
+}
+
+// getWindowStart returns the first block of the window that ends with the specified sample. Window is cut if there are no samples for its beginning
+func getWindowStart(s Storage, w ChainStatsWindow, first, end *ChainStatsSample) *ChainStatsSample {
+	if w.Blocks != 0 {
+		start := first.Number
+		if end.Number > first.Number+w.Blocks {
+			start = end.Number - w.Blocks
+		}
+		return s.GetChainStatsSample(start)
+	}
+	from := uint64(0)
+	if end.Timestamp > w.Duration {
+		from = end.Timestamp - w.Duration
+	}
+	count := int(end.Number - first.Number)
+	idx := sort.Search(count, func(i int) bool {
+		sample := s.GetChainStatsSample(first.Number + uint64(i))
+		return sample == nil || sample.Timestamp >= from
+	})
+	return s.GetChainStatsSample(first.Number + uint64(idx))
+}
+
+func calculateChainStats(start, end *ChainStatsSample) *models.ChainStats {
+	stats := &models.ChainStats{StartBlock: start.Number, EndBlock: end.Number, EndTimestamp: end.Timestamp}
+	timeDiff := end.Timestamp - start.Timestamp
+	stats.BlockInterval = float32(timeDiff) / float32(end.Number-start.Number)
+	if timeDiff > 0 {
+		stats.Tps = float32(end.TrxCount-start.TrxCount) / float32(timeDiff)
+	}
+	return stats
+}
+
+// GetChainStats returns stats for the window that ends with the specified block. Returns nil if there are no samples for it
+func GetChainStats(s Storage, w ChainStatsWindow, endBlock uint64) *models.ChainStats {
+	first := getFirstChainStatsSample(s)
+	if first == nil {
+		return nil
+	}
+	return getChainStats(s, w, first, endBlock)
+}
+
+func getChainStats(s Storage, w ChainStatsWindow, first *ChainStatsSample, endBlock uint64) *models.ChainStats {
+	end := s.GetChainStatsSample(endBlock)
+	if end == nil || end.Number <= first.Number {
+		return nil
+	}
+	start := getWindowStart(s, w, first, end)
+	if start == nil || start.Number >= end.Number {
+		return nil
+	}
+	return calculateChainStats(start, end)
+}
+
+// GetChainStatsHistory returns stats for the window calculated at the specified number of evenly distributed blocks in the [from, to] range
+func GetChainStatsHistory(s Storage, w ChainStatsWindow, from, to, points uint64) []models.ChainStats {
+	ret := make([]models.ChainStats, 0, points)
+	first := getFirstChainStatsSample(s)
+	if first == nil || points == 0 {
+		return ret
+	}
+	if from <= first.Number {
+		from = first.Number + 1
+	}
+	if from > to {
+		return ret
+	}
+	if points > to-from+1 {
+		points = to - from + 1
+	}
+	for i := uint64(0); i < points; i++ {
+		block := to
+		if points > 1 {
+			block = from + (to-from)*i/(points-1)
+		}
+		if stats := getChainStats(s, w, first, block); stats != nil {
+			ret = append(ret, *stats)
+		}
+	}
+	return ret
+}
@@ -0,0 +1,46 @@
+package storage
+
+import (
+	"strings"
+
+	"github.com/dailycrypto-me/daily-indexer/internal/contracts"
+	"github.com/ethereum/go-ethereum/rlp"
+	log "github.com/sirupsen/logrus"
+)
+
+// ContractABI is an ABI uploaded for the contract. Stored as JSON to be parsed on startup
+type ContractABI struct {
+	Address string `json:"address"`
+	ABI     string `json:"abi"`
+}
+
+// LoadContractABIs registers all ABIs saved in the storage in the registry
+func LoadContractABIs(s Storage, r *contracts.Registry) {
+	count := 0
+	s.ForEach(new(ContractABI), "", nil, func(_, res []byte) (stop bool) {
+		var o ContractABI
+		err := rlp.DecodeBytes(res, &o)
+		if err != nil {
+			log.WithError(err).Fatal("Error decoding contract ABI from db")
+		}
+		if _, err := r.Register(o.Address, o.ABI); err != nil {
+			log.WithError(err).WithField("address", o.Address).Error("Failed to parse stored contract ABI")
+			return
+		}
+		count++
+		return
+	})
+	log.WithField("count", count).Info("Loaded contract ABIs")
+}
+
+// SaveContractABI registers ABI in the registry and saves it to the storage, so it will be loaded after restart
+func SaveContractABI(s Storage, r *contracts.Registry, address, abiJSON string) error {
+	address = strings.ToLower(address)
+	if _, err := r.Register(address, abiJSON); err != nil {
+		return err
+	}
+	b := s.NewBatch()
+	b.AddSingleKey(ContractABI{Address: address, ABI: r.GetRaw(address)}, address)
+	b.CommitBatch()
+	return nil
+}
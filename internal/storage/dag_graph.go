@@ -0,0 +1,58 @@
+package storage
+
+import (
+	"strings"
+
+	"github.com/dailycrypto-me/daily-indexer/models"
+)
+
+// DagNode is the DAG block of the period with the references to its pivot and tips
+type DagNode struct {
+	Hash             string
+	Sender           string
+	Level            uint64
+	Timestamp        uint64
+	TransactionCount uint64
+	Pivot            string
+	Tips             []string
+}
+
+// DagGraph is the DAG blocks of the period in the finalization order. It is saved with the period key
+type DagGraph struct {
+	Period uint64
+	Nodes  []DagNode
+}
+
+// MakeDagGraph makes the graph of the period DAG blocks. Blocks are expected in the finalization order as they are returned for the period
+func MakeDagGraph(period uint64, dags []DagNode) *DagGraph {
+	ret := &DagGraph{Period: period, Nodes: make([]DagNode, 0, len(dags))}
+	for _, dag := range dags {
+		dag.Hash = strings.ToLower(dag.Hash)
+		ret.Nodes = append(ret.Nodes, dag)
+	}
+	return ret
+}
+
+// ToModel returns nodes with their order in the period and edges from every block to its pivot and tips
+func (g *DagGraph) ToModel() models.DagGraph {
+	ret := models.DagGraph{Period: g.Period, Nodes: make([]models.DagNode, 0, len(g.Nodes)), Edges: make([]models.DagEdge, 0)}
+	for k, node := range g.Nodes {
+		ret.Nodes = append(ret.Nodes, models.DagNode{
+			Hash:             node.Hash,
+			Sender:           node.Sender,
+			Level:            node.Level,
+			Timestamp:        node.Timestamp,
+			TransactionCount: node.TransactionCount,
+			Order:            uint64(k),
+			Pivot:            node.Pivot,
+			Tips:             node.Tips,
+		})
+		if node.Pivot != "" {
+			ret.Edges = append(ret.Edges, models.DagEdge{From: node.Hash, To: node.Pivot, Type: models.Pivot})
+		}
+		for _, tip := range node.Tips {
+			ret.Edges = append(ret.Edges, models.DagEdge{From: node.Hash, To: tip, Type: models.Tip})
+		}
+	}
+	return ret
+}
@@ -0,0 +1,93 @@
+package storage
+
+import (
+	"strings"
+
+	"github.com/dailycrypto-me/daily-indexer/models"
+	"github.com/ethereum/go-ethereum/rlp"
+	log "github.com/sirupsen/logrus"
+)
+
+// DagProductionStats is the DAG blocks production of the validator in the ISO week. It is saved with the week start key
+type DagProductionStats struct {
+	Start               uint64
+	DagsCount           uint64
+	RewardableDagsCount uint64
+	TransactionsCount   uint64
+	DifficultySum       uint64
+}
+
+// PeriodDag is the part of the DAG block data used for the production and inclusion stats
+type PeriodDag struct {
+	Sender       string
+	Timestamp    uint64
+	Difficulty   uint16
+	Transactions []string
+}
+
+// AddPeriodDags adds DAG blocks of the period to the weekly stats of their producers.
+// Rewardable DAG blocks count is taken from the rewards stats of the period
+func AddPeriodDags(s Storage, b Batch, timestamp uint64, dags []PeriodDag, rewardsStats *RewardsStats) {
+	if len(dags) == 0 {
+		return
+	}
+	weekStart := GetWeekStart(timestamp)
+	stats := make(map[string]*DagProductionStats)
+	getStats := func(sender string) *DagProductionStats {
+		st := stats[sender]
+		if st == nil {
+			loaded := s.GetDagProductionStats(sender, weekStart)
+			st = &loaded
+			stats[sender] = st
+		}
+		return st
+	}
+	for _, dag := range dags {
+		st := getStats(strings.ToLower(dag.Sender))
+		st.DagsCount++
+		st.TransactionsCount += uint64(len(dag.Transactions))
+		st.DifficultySum += uint64(dag.Difficulty)
+	}
+	if rewardsStats != nil {
+		for _, validator := range rewardsStats.ValidatorsStats {
+			if validator.DagBlocksCount > 0 {
+				getStats(strings.ToLower(validator.Address)).RewardableDagsCount += validator.DagBlocksCount
+			}
+		}
+	}
+	for sender, st := range stats {
+		b.Add(st, sender, weekStart)
+	}
+}
+
+func (d *DagProductionStats) ToModel() models.DagProductionStats {
+	ret := models.DagProductionStats{
+		Start:               d.Start,
+		DagsCount:           d.DagsCount,
+		RewardableDagsCount: d.RewardableDagsCount,
+		UnrewardedDagsCount: d.DagsCount - d.RewardableDagsCount,
+		TransactionsCount:   d.TransactionsCount,
+	}
+	if d.DagsCount > 0 {
+		ret.AverageDifficulty = float64(d.DifficultySum) / float64(d.DagsCount)
+	}
+	return ret
+}
+
+// GetDagProductionStats returns weekly DAG production stats of the validator for weeks started in the [from, to] range
+func GetDagProductionStats(s Storage, validator string, from, to uint64) []models.DagProductionStats {
+	ret := make([]models.DagProductionStats, 0)
+	s.ForEach(new(DagProductionStats), validator, &from, func(_, res []byte) (stop bool) {
+		var stats DagProductionStats
+		err := rlp.DecodeBytes(res, &stats)
+		if err != nil {
+			log.WithError(err).Fatal("Error decoding DAG production stats from db")
+		}
+		if stats.Start > to {
+			return true
+		}
+		ret = append(ret, stats.ToModel())
+		return false
+	})
+	return ret
+}
@@ -0,0 +1,105 @@
+package storage
+
+import (
+	"math/big"
+	"sort"
+
+	"github.com/dailycrypto-me/daily-indexer/models"
+)
+
+// nakamotoThreshold is the share of the distribution that is enough to halt PBFT consensus
+var nakamotoThreshold = big.NewRat(1, 3)
+
+// WeekValidatorSet is the validator set at the last block of the week. It is saved with FormatWeek key
+type WeekValidatorSet ValidatorSet
+
+// GetDistributionMetrics calculates Nakamoto coefficient(number of participants that control more than 1/3), Gini coefficient and share of the top participants
+func GetDistributionMetrics(values []*big.Int, top int) models.DistributionMetrics {
+	sorted := make([]*big.Int, 0, len(values))
+	total := big.NewInt(0)
+	for _, v := range values {
+		if v.Sign() > 0 {
+			sorted = append(sorted, v)
+			total.Add(total, v)
+		}
+	}
+	ret := models.DistributionMetrics{Participants: uint64(len(sorted)), Total: total.String()}
+	if total.Sign() == 0 {
+		return ret
+	}
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i].Cmp(sorted[j]) > 0 })
+
+	accumulated := big.NewInt(0)
+	topSum := big.NewInt(0)
+	for i, v := range sorted {
+		accumulated.Add(accumulated, v)
+		if i < top {
+			topSum.Add(topSum, v)
+		}
+		if ret.NakamotoCoefficient == 0 && new(big.Rat).SetFrac(accumulated, total).Cmp(nakamotoThreshold) > 0 {
+			ret.NakamotoCoefficient = uint64(i + 1)
+		}
+	}
+	topShare, _ := new(big.Rat).SetFrac(topSum, total).Float64()
+	ret.TopShare = float32(topShare)
+
+	// G = 2 * sum(i * x_i) / (n * sum(x)) - (n + 1) / n, where values are sorted ascending and i starts from 1
+	n := int64(len(sorted))
+	weighted := big.NewInt(0)
+	for i, v := range sorted {
+		weighted.Add(weighted, big.NewInt(0).Mul(big.NewInt(n-int64(i)), v))
+	}
+	gini := new(big.Rat).SetFrac(big.NewInt(0).Mul(weighted, big.NewInt(2)), big.NewInt(0).Mul(total, big.NewInt(n)))
+	gini.Sub(gini, big.NewRat(n+1, n))
+	giniValue, _ := gini.Float64()
+	ret.Gini = float32(giniValue)
+	return ret
+}
+
+func (w *WeekStats) pbftCounts() []*big.Int {
+	ret := make([]*big.Int, 0, len(w.Validators))
+	for _, v := range w.Validators {
+		ret = append(ret, big.NewInt(0).SetUint64(v.PbftCount))
+	}
+	return ret
+}
+
+func (w *WeekStats) dagCounts() []*big.Int {
+	ret := make([]*big.Int, 0, len(w.Metrics))
+	for _, v := range w.Metrics {
+		ret = append(ret, big.NewInt(0).SetUint64(v.DagsCount))
+	}
+	return ret
+}
+
+func (v *ValidatorSet) stakes() []*big.Int {
+	ret := make([]*big.Int, 0, len(v.Validators))
+	for _, validator := range v.Validators {
+		ret = append(ret, validator.Stake)
+	}
+	return ret
+}
+
+// GetDecentralizationStats returns decentralization metrics of the week. Stakes are taken from the set at the end of the week or the current set if it isn't finished yet.
+// Returns nil if there is no stake data for the week
+func GetDecentralizationStats(s Storage, year, week int32, current bool, top int) *models.DecentralizationStats {
+	var set *ValidatorSet
+	if current {
+		last := s.GetLastValidatorSet()
+		set = &last
+	} else {
+		set = s.GetWeekValidatorSet(year, week)
+	}
+	if set == nil {
+		return nil
+	}
+	weekStats := s.GetWeekStats(year, week)
+	return &models.DecentralizationStats{
+		Year:  uint32(year),
+		Week:  uint32(week),
+		Block: set.Block,
+		Stake: GetDistributionMetrics(set.stakes(), top),
+		Pbft:  GetDistributionMetrics(weekStats.pbftCounts(), top),
+		Dags:  GetDistributionMetrics(weekStats.dagCounts(), top),
+	}
+}
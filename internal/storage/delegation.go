@@ -0,0 +1,258 @@
+package storage
+
+import (
+	"math/big"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/dailycrypto-me/daily-indexer/internal/events"
+	"github.com/dailycrypto-me/daily-indexer/models"
+	"github.com/ethereum/go-ethereum/rlp"
+	log "github.com/sirupsen/logrus"
+)
+
+// Delegation is the current stake of the delegator at the validator
+type Delegation struct {
+	Delegator       string   `json:"delegator"`
+	Validator       string   `json:"validator"`
+	Amount          *big.Int `json:"amount"`
+	LastChangeBlock uint64   `json:"lastChangeBlock"`
+}
+
+// DelegationByValidator is used to select prefix of the delegations index by validator. Delegations are saved by delegator as Delegation
+type DelegationByValidator Delegation
+
+// DelegationEvent is used to select prefix of delegation events by delegator
+type DelegationEvent models.DelegationEvent
+
+func (d *Delegation) ToModel() models.Delegation {
+	return models.Delegation{
+		Delegator:       d.Delegator,
+		Validator:       d.Validator,
+		Amount:          d.Amount.String(),
+		LastChangeBlock: d.LastChangeBlock,
+	}
+}
+
+// Undelegation is a pending undelegation request. Undelegations made before V2 events have no id, so they are saved with zero id
+type Undelegation struct {
+	Delegator        string   `json:"delegator"`
+	Validator        string   `json:"validator"`
+	Id               uint64   `json:"id"`
+	Amount           *big.Int `json:"amount"`
+	RequestBlock     uint64   `json:"requestBlock"`
+	RequestTimestamp uint64   `json:"requestTimestamp"`
+	TransactionHash  string   `json:"transactionHash"`
+}
+
+func (u *Undelegation) ToModel(lockingPeriod, currentBlock uint64) models.Undelegation {
+	unlockBlock := u.RequestBlock + lockingPeriod
+	return models.Undelegation{
+		Delegator:        u.Delegator,
+		Validator:        u.Validator,
+		Id:               u.Id,
+		Amount:           u.Amount.String(),
+		RequestBlock:     u.RequestBlock,
+		RequestTimestamp: u.RequestTimestamp,
+		UnlockBlock:      unlockBlock,
+		Withdrawable:     currentBlock >= unlockBlock,
+		TransactionHash:  u.TransactionHash,
+	}
+}
+
+// DelegationsMap keeps delegations and undelegations changed in the block along with the events that changed them
+type DelegationsMap struct {
+	m             sync.Mutex
+	delegations   map[string]*Delegation
+	undelegations map[string]*Undelegation
+	events        map[string]models.DelegationEvent
+}
+
+func MakeDelegationsMap() *DelegationsMap {
+	return &DelegationsMap{
+		delegations:   make(map[string]*Delegation),
+		undelegations: make(map[string]*Undelegation),
+		events:        make(map[string]models.DelegationEvent),
+	}
+}
+
+func getUndelegationKey(delegator, validator string, id uint64) string {
+	return delegator + validator + FormatIntToKey(id)
+}
+
+func (d *DelegationsMap) get(s Storage, delegator, validator string) *Delegation {
+	delegator = strings.ToLower(delegator)
+	validator = strings.ToLower(validator)
+	key := delegator + validator
+	if delegation := d.delegations[key]; delegation != nil {
+		return delegation
+	}
+	delegation := s.GetDelegation(delegator, validator)
+	d.delegations[key] = &delegation
+	return &delegation
+}
+
+func (d *DelegationsMap) add(s Storage, delegator, validator string, value *big.Int, block uint64) {
+	delegation := d.get(s, delegator, validator)
+	delegation.Amount.Add(delegation.Amount, value)
+	delegation.LastChangeBlock = block
+	if delegation.Amount.Sign() < 0 {
+		log.WithFields(log.Fields{"delegator": delegator, "validator": validator, "amount": delegation.Amount}).Error("Negative delegation amount")
+	}
+}
+
+// AddDelegation is used to add delegations that were made without DPOS events(in genesis)
+func (d *DelegationsMap) AddDelegation(s Storage, delegator, validator string, value *big.Int, block uint64) {
+	d.m.Lock()
+	defer d.m.Unlock()
+	d.add(s, delegator, validator, value, block)
+}
+
+// UpdateEvents applies delegation changes from DPOS contract events
+func (d *DelegationsMap) UpdateEvents(s Storage, blockNumber, timestamp uint64, logs []models.EventLog) error {
+	if len(logs) == 0 {
+		return nil
+	}
+	delegationEvents, err := events.DecodeDelegationTopics(logs)
+	if err != nil {
+		return err
+	}
+
+	d.m.Lock()
+	defer d.m.Unlock()
+	for _, e := range delegationEvents {
+		undelegationKey := getUndelegationKey(e.Delegator, e.Validator, e.UndelegationId)
+		switch e.EventName {
+		case events.DelegatedEvent:
+			d.add(s, e.Delegator, e.Validator, e.Value, blockNumber)
+		case events.UndelegateCanceledEvent, events.UndelegateCanceledV2Event:
+			d.add(s, e.Delegator, e.Validator, e.Value, blockNumber)
+			d.undelegations[undelegationKey] = nil
+		case events.UndelegateConfirmedEvent, events.UndelegateConfirmedV2Event:
+			d.undelegations[undelegationKey] = nil
+		case events.UndelegatedEvent, events.UndelegatedV2Event:
+			d.add(s, e.Delegator, e.Validator, big.NewInt(0).Neg(e.Value), blockNumber)
+			d.undelegations[undelegationKey] = &Undelegation{
+				Delegator:        e.Delegator,
+				Validator:        e.Validator,
+				Id:               e.UndelegationId,
+				Amount:           e.Value,
+				RequestBlock:     blockNumber,
+				RequestTimestamp: timestamp,
+				TransactionHash:  e.TransactionHash,
+			}
+		case events.RedelegatedEvent:
+			d.add(s, e.Delegator, e.Validator, big.NewInt(0).Neg(e.Value), blockNumber)
+			d.add(s, e.Delegator, e.ToValidator, e.Value, blockNumber)
+		}
+		key := FormatLogKey(e.Delegator, blockNumber, e.TransactionIndex, e.LogIndex)
+		d.events[key] = models.DelegationEvent{
+			Type:            e.EventName,
+			Delegator:       e.Delegator,
+			Validator:       e.Validator,
+			ToValidator:     e.ToValidator,
+			Amount:          e.Value.String(),
+			BlockNumber:     blockNumber,
+			Timestamp:       timestamp,
+			TransactionHash: e.TransactionHash,
+		}
+	}
+	return nil
+}
+
+func (d *DelegationsMap) AddToBatch(b Batch) {
+	d.m.Lock()
+	defer d.m.Unlock()
+	for _, delegation := range d.delegations {
+		key := delegation.Delegator + delegation.Validator
+		validatorKey := delegation.Validator + delegation.Delegator
+		if delegation.Amount.Sign() == 0 {
+			b.RemoveSingleKey(delegation, key)
+			b.RemoveSingleKey(DelegationByValidator(*delegation), validatorKey)
+			continue
+		}
+		b.AddSingleKey(delegation, key)
+		b.AddSingleKey(DelegationByValidator(*delegation), validatorKey)
+	}
+	for key, undelegation := range d.undelegations {
+		if undelegation == nil {
+			b.RemoveSingleKey(Undelegation{}, key)
+			continue
+		}
+		b.AddSingleKey(undelegation, key)
+	}
+	for key, event := range d.events {
+		b.AddSingleKey(DelegationEvent(event), key)
+	}
+}
+
+func getDelegations(s Storage, o interface{}, address string) (ret []Delegation, total *big.Int) {
+	total = big.NewInt(0)
+	s.ForEach(o, address, nil, func(_, res []byte) (stop bool) {
+		var d Delegation
+		err := rlp.DecodeBytes(res, &d)
+		if err != nil {
+			log.WithError(err).Fatal("Error decoding delegation from db")
+		}
+		ret = append(ret, d)
+		total.Add(total, d.Amount)
+		return false
+	})
+	sort.Slice(ret, func(i, j int) bool {
+		return ret[i].Amount.Cmp(ret[j].Amount) > 0
+	})
+	return
+}
+
+// GetDelegations returns current delegations of the delegator sorted by amount
+func GetDelegations(s Storage, delegator string) ([]Delegation, *big.Int) {
+	return getDelegations(s, new(Delegation), delegator)
+}
+
+// GetDelegators returns current delegations to the validator sorted by amount
+func GetDelegators(s Storage, validator string) ([]Delegation, *big.Int) {
+	return getDelegations(s, new(DelegationByValidator), validator)
+}
+
+// GetUndelegations returns pending undelegations of the delegator
+func GetUndelegations(s Storage, delegator string) (ret []Undelegation) {
+	s.ForEach(new(Undelegation), delegator, nil, func(_, res []byte) (stop bool) {
+		var u Undelegation
+		err := rlp.DecodeBytes(res, &u)
+		if err != nil {
+			log.WithError(err).Fatal("Error decoding undelegation from db")
+		}
+		ret = append(ret, u)
+		return false
+	})
+	sort.Slice(ret, func(i, j int) bool {
+		return ret[i].RequestBlock < ret[j].RequestBlock
+	})
+	return
+}
+
+// GetDelegationEventsPage returns delegation events of the delegator starting from the latest
+func GetDelegationEventsPage(s Storage, delegator string, from, count uint64) (ret *models.DelegationEventsPaginatedResponse) {
+	ret = &models.DelegationEventsPaginatedResponse{Start: from, Data: make([]models.DelegationEvent, 0, count)}
+	skipped := uint64(0)
+	s.ForEachBackwards(new(DelegationEvent), delegator, nil, func(_, res []byte) (stop bool) {
+		if skipped < from {
+			skipped++
+			return false
+		}
+		if uint64(len(ret.Data)) == count {
+			ret.HasNext = true
+			return true
+		}
+		var e models.DelegationEvent
+		err := rlp.DecodeBytes(res, &e)
+		if err != nil {
+			log.WithError(err).Fatal("Error decoding delegation event from db")
+		}
+		ret.Data = append(ret.Data, e)
+		return false
+	})
+	ret.End = from + uint64(len(ret.Data))
+	return
+}
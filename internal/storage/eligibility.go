@@ -0,0 +1,78 @@
+package storage
+
+import (
+	"math/big"
+	"sort"
+
+	"github.com/dailycrypto-me/daily-indexer/internal/common"
+	"github.com/dailycrypto-me/daily-indexer/models"
+	"github.com/ethereum/go-ethereum/rlp"
+	log "github.com/sirupsen/logrus"
+)
+
+func decodeStakeChange(res []byte) (change ValidatorStakeChange) {
+	err := rlp.DecodeBytes(res, &change)
+	if err != nil {
+		log.WithError(err).Fatal("Error decoding validator stake change from db")
+	}
+	return
+}
+
+func crossesEligibility(c *common.Config, change *ValidatorStakeChange) bool {
+	return c.IsEligible(change.Stake) != c.IsEligible(change.PreviousStake)
+}
+
+// GetEligibilityChanges returns blocks in the [from, to] range where the validator stake crossed the eligibility threshold
+func GetEligibilityChanges(s Storage, c *common.Config, validator string, from, to uint64) []models.EligibilityChange {
+	ret := make([]models.EligibilityChange, 0)
+	s.ForEach(new(ValidatorStakeChange), validator, &from, func(_, res []byte) (stop bool) {
+		change := decodeStakeChange(res)
+		if change.Block > to {
+			return true
+		}
+		if crossesEligibility(c, &change) {
+			ret = append(ret, models.EligibilityChange{Block: change.Block, Timestamp: change.Timestamp, Stake: change.Stake.String(), Eligible: c.IsEligible(change.Stake)})
+		}
+		return false
+	})
+	return ret
+}
+
+// getEligibleSince returns the block of the last eligibility change of the validator at or before the block.
+// Stake history is scanned from the latest change and the scan stops at the first crossing of the threshold
+func getEligibleSince(s Storage, c *common.Config, validator string, block uint64) (since uint64) {
+	s.ForEachBackwards(new(ValidatorStakeChange), validator, nil, func(_, res []byte) (stop bool) {
+		change := decodeStakeChange(res)
+		if change.Block > block || !crossesEligibility(c, &change) {
+			return false
+		}
+		since = change.Block
+		return true
+	})
+	return
+}
+
+// GetEligibleValidators returns validators of the set which stake meets the eligibility threshold sorted by stake descending.
+// Distance is the stake above the threshold that validator can withdraw without dropping out of the consensus
+func GetEligibleValidators(s Storage, c *common.Config, set *ValidatorSet) models.EligibleValidatorsResponse {
+	threshold := c.GetEligibilityThreshold()
+	ret := models.EligibleValidatorsResponse{Block: set.Block, Threshold: threshold.String(), Data: make([]models.EligibleValidator, 0)}
+	eligible := make([]ValidatorStake, 0, len(set.Validators))
+	for _, validator := range set.Validators {
+		if c.IsEligible(validator.Stake) {
+			eligible = append(eligible, validator)
+		}
+	}
+	sort.SliceStable(eligible, func(i, j int) bool {
+		return eligible[i].Stake.Cmp(eligible[j].Stake) > 0
+	})
+	for _, validator := range eligible {
+		ret.Data = append(ret.Data, models.EligibleValidator{
+			Address:       validator.Address,
+			Stake:         validator.Stake.String(),
+			Distance:      big.NewInt(0).Sub(validator.Stake, threshold).String(),
+			EligibleSince: getEligibleSince(s, c, validator.Address, set.Block),
+		})
+	}
+	return ret
+}
@@ -0,0 +1,165 @@
+package storage
+
+import (
+	"sort"
+
+	"github.com/dailycrypto-me/daily-indexer/models"
+	"github.com/ethereum/go-ethereum/rlp"
+	log "github.com/sirupsen/logrus"
+)
+
+// GasPriceStats is the gas price distribution of the block transactions. It is saved only for blocks with transactions
+type GasPriceStats struct {
+	Period            uint64
+	Timestamp         uint64
+	TransactionsCount uint64
+	GasUsed           uint64
+	Min               uint64
+	P10               uint64
+	P25               uint64
+	Median            uint64
+	P75               uint64
+	P90               uint64
+	Max               uint64
+}
+
+// percentile returns nearest-rank percentile of the sorted values
+func percentile(sorted []uint64, p uint64) uint64 {
+	if len(sorted) == 0 {
+		return 0
+	}
+	rank := (uint64(len(sorted))*p + 99) / 100
+	if rank == 0 {
+		rank = 1
+	}
+	return sorted[rank-1]
+}
+
+// MakeGasPriceStats calculates distribution of the transaction gas prices. Returns nil if there are no transactions
+func MakeGasPriceStats(period, timestamp uint64, prices []uint64, gasUsed uint64) *GasPriceStats {
+	if len(prices) == 0 {
+		return nil
+	}
+	sorted := make([]uint64, len(prices))
+	copy(sorted, prices)
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i] < sorted[j] })
+	return &GasPriceStats{
+		Period:            period,
+		Timestamp:         timestamp,
+		TransactionsCount: uint64(len(sorted)),
+		GasUsed:           gasUsed,
+		Min:               sorted[0],
+		P10:               percentile(sorted, 10),
+		P25:               percentile(sorted, 25),
+		Median:            percentile(sorted, 50),
+		P75:               percentile(sorted, 75),
+		P90:               percentile(sorted, 90),
+		Max:               sorted[len(sorted)-1],
+	}
+}
+
+// getMedian returns median of the values selected from the blocks stats
+func getMedian(blocks []GasPriceStats, value func(*GasPriceStats) uint64) uint64 {
+	values := make([]uint64, 0, len(blocks))
+	for i := range blocks {
+		values = append(values, value(&blocks[i]))
+	}
+	sort.Slice(values, func(i, j int) bool { return values[i] < values[j] })
+	return percentile(values, 50)
+}
+
+// aggregateGasStats combines stats of the blocks in the window. Percentiles are medians of the blocks percentiles, so every block has the same weight.
+// Suggested prices are: safe low - typical minimal price included in a block, standard - typical median price, fast - typical 90th percentile price
+func aggregateGasStats(blocks []GasPriceStats, from, to uint64) *models.GasStats {
+	if len(blocks) == 0 {
+		return nil
+	}
+	ret := &models.GasStats{
+		FromBlock:    from,
+		ToBlock:      to,
+		BlocksCount:  uint64(len(blocks)),
+		EndTimestamp: blocks[len(blocks)-1].Timestamp,
+		Min:          blocks[0].Min,
+		Max:          blocks[0].Max,
+	}
+	for _, b := range blocks {
+		ret.TransactionsCount += b.TransactionsCount
+		ret.GasUsed += b.GasUsed
+		ret.Min = min(ret.Min, b.Min)
+		ret.Max = max(ret.Max, b.Max)
+	}
+	ret.P10 = getMedian(blocks, func(b *GasPriceStats) uint64 { return b.P10 })
+	ret.P25 = getMedian(blocks, func(b *GasPriceStats) uint64 { return b.P25 })
+	ret.Median = getMedian(blocks, func(b *GasPriceStats) uint64 { return b.Median })
+	ret.P75 = getMedian(blocks, func(b *GasPriceStats) uint64 { return b.P75 })
+	ret.P90 = getMedian(blocks, func(b *GasPriceStats) uint64 { return b.P90 })
+	ret.SafeLow = getMedian(blocks, func(b *GasPriceStats) uint64 { return b.Min })
+	ret.Standard = ret.Median
+	ret.Fast = ret.P90
+	return ret
+}
+
+func getGasPriceStatsRange(s Storage, from, to uint64) []GasPriceStats {
+	ret := make([]GasPriceStats, 0)
+	s.ForEach(new(GasPriceStats), "", &from, func(_, res []byte) (stop bool) {
+		var stats GasPriceStats
+		err := rlp.DecodeBytes(res, &stats)
+		if err != nil {
+			log.WithError(err).Fatal("Error decoding gas price stats from db")
+		}
+		if stats.Period > to {
+			return true
+		}
+		ret = append(ret, stats)
+		return false
+	})
+	return ret
+}
+
+func getWindowFrom(to, blocks uint64) uint64 {
+	if to < blocks {
+		return 0
+	}
+	return to - blocks + 1
+}
+
+// GetGasStats returns gas price stats of the specified number of blocks ending with the specified block. Returns nil if there were no transactions in the window
+func GetGasStats(s Storage, blocks, to uint64) *models.GasStats {
+	from := getWindowFrom(to, blocks)
+	return aggregateGasStats(getGasPriceStatsRange(s, from, to), from, to)
+}
+
+// GetGasStatsHistory returns gas price stats of the window calculated at the specified number of evenly distributed blocks in the [from, to] range.
+// Windows of the points can overlap, so block stats are loaded once and the window slides over them
+func GetGasStatsHistory(s Storage, blocks, from, to, points uint64) []models.GasStats {
+	ret := make([]models.GasStats, 0, points)
+	if points == 0 || from > to {
+		return ret
+	}
+	if points > to-from+1 {
+		points = to - from + 1
+	}
+	window := make([]GasPriceStats, 0)
+	loaded, hasLoaded := uint64(0), false
+	for i := uint64(0); i < points; i++ {
+		block := to
+		if points > 1 {
+			block = from + (to-from)*i/(points-1)
+		}
+		windowFrom := getWindowFrom(block, blocks)
+		loadFrom := windowFrom
+		if hasLoaded && loaded+1 > loadFrom {
+			loadFrom = loaded + 1
+		}
+		if loadFrom <= block {
+			window = append(window, getGasPriceStatsRange(s, loadFrom, block)...)
+		}
+		loaded, hasLoaded = block, true
+		start := sort.Search(len(window), func(k int) bool { return window[k].Period >= windowFrom })
+		window = window[start:]
+		if stats := aggregateGasStats(window, windowFrom, block); stats != nil {
+			ret = append(ret, *stats)
+		}
+	}
+	return ret
+}
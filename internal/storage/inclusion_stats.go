@@ -0,0 +1,123 @@
+package storage
+
+import (
+	"github.com/dailycrypto-me/daily-indexer/models"
+	"github.com/ethereum/go-ethereum/rlp"
+	log "github.com/sirupsen/logrus"
+)
+
+// TransactionInclusion is the time in seconds between the first DAG block that included the transaction and the PBFT block that finalized it
+// along with the number of DAG blocks of the period that included the transaction
+type TransactionInclusion struct {
+	Latency   uint64
+	DagsCount uint64
+}
+
+// InclusionStats is the transactions inclusion latency aggregated by period or day. Start is the period number or the day start timestamp
+type InclusionStats struct {
+	Start             uint64
+	TransactionsCount uint64
+	LatencySum        uint64
+	MinLatency        uint64
+	MaxLatency        uint64
+	DagInclusions     uint64
+}
+
+// PeriodInclusionStats is used to select prefix of the inclusion stats of PBFT periods
+type PeriodInclusionStats InclusionStats
+
+// DailyInclusionStats is used to select prefix of the inclusion stats aggregated by UTC days
+type DailyInclusionStats InclusionStats
+
+// GetTransactionsInclusion returns inclusion of the period transactions into the period DAG blocks. Transactions that aren't included in the period DAG blocks are skipped
+func GetTransactionsInclusion(timestamp uint64, dags []PeriodDag, transactions []string) map[string]TransactionInclusion {
+	ret := make(map[string]TransactionInclusion, len(transactions))
+	first := make(map[string]uint64, len(transactions))
+	for _, hash := range transactions {
+		ret[hash] = TransactionInclusion{}
+	}
+	for _, dag := range dags {
+		for _, hash := range dag.Transactions {
+			inclusion, ok := ret[hash]
+			if !ok {
+				continue
+			}
+			if inclusion.DagsCount == 0 || dag.Timestamp < first[hash] {
+				first[hash] = dag.Timestamp
+			}
+			inclusion.DagsCount++
+			ret[hash] = inclusion
+		}
+	}
+	for hash, inclusion := range ret {
+		if inclusion.DagsCount == 0 {
+			delete(ret, hash)
+			continue
+		}
+		if first[hash] < timestamp {
+			inclusion.Latency = timestamp - first[hash]
+		}
+		ret[hash] = inclusion
+	}
+	return ret
+}
+
+func (i *InclusionStats) add(inclusion TransactionInclusion) {
+	if i.TransactionsCount == 0 || inclusion.Latency < i.MinLatency {
+		i.MinLatency = inclusion.Latency
+	}
+	if inclusion.Latency > i.MaxLatency {
+		i.MaxLatency = inclusion.Latency
+	}
+	i.TransactionsCount++
+	i.LatencySum += inclusion.Latency
+	i.DagInclusions += inclusion.DagsCount
+}
+
+// AddPeriodInclusions saves inclusion stats of the period and adds them to the day that contains the period timestamp
+func AddPeriodInclusions(s Storage, b Batch, period, timestamp uint64, inclusions map[string]TransactionInclusion) {
+	if len(inclusions) == 0 {
+		return
+	}
+	periodStats := InclusionStats{Start: period}
+	dayStart := GetDayStart(timestamp)
+	daily := s.GetInclusionStats(new(DailyInclusionStats), dayStart)
+	for _, inclusion := range inclusions {
+		periodStats.add(inclusion)
+		daily.add(inclusion)
+	}
+	b.Add(PeriodInclusionStats(periodStats), "", period)
+	b.Add(DailyInclusionStats(daily), "", dayStart)
+}
+
+func (i *InclusionStats) ToModel() models.InclusionStats {
+	ret := models.InclusionStats{
+		Start:             i.Start,
+		TransactionsCount: i.TransactionsCount,
+		MinLatency:        i.MinLatency,
+		MaxLatency:        i.MaxLatency,
+		Duplicates:        i.DagInclusions - i.TransactionsCount,
+	}
+	if i.TransactionsCount > 0 {
+		ret.AverageLatency = float64(i.LatencySum) / float64(i.TransactionsCount)
+	}
+	return ret
+}
+
+// GetInclusionStatsSeries returns inclusion stats for periods or days started in the [from, to] range. o selects period or daily stats
+func GetInclusionStatsSeries(s Storage, o interface{}, from, to uint64) []models.InclusionStats {
+	ret := make([]models.InclusionStats, 0)
+	s.ForEach(o, "", &from, func(_, res []byte) (stop bool) {
+		var stats InclusionStats
+		err := rlp.DecodeBytes(res, &stats)
+		if err != nil {
+			log.WithError(err).Fatal("Error decoding inclusion stats from db")
+		}
+		if stats.Start > to {
+			return true
+		}
+		ret = append(ret, stats.ToModel())
+		return false
+	})
+	return ret
+}
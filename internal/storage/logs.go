@@ -0,0 +1,112 @@
+package storage
+
+import (
+	"strings"
+
+	"github.com/dailycrypto-me/daily-indexer/models"
+	"github.com/ethereum/go-ethereum/rlp"
+	log "github.com/sirupsen/logrus"
+)
+
+// AddressLog is used to select prefix of the logs index by emitting contract address
+type AddressLog models.IndexedEventLog
+
+// TopicLog is used to select prefix of the logs index by the first topic
+type TopicLog models.IndexedEventLog
+
+type LogsFilter struct {
+	Address   *string
+	Topic0    *string
+	Topic1    *string
+	FromBlock uint64
+	ToBlock   uint64
+}
+
+// FormatLogKey returns key of the log in the logs indexes. Logs are ordered by their position in the chain
+func FormatLogKey(key string, blockNumber, transactionIndex, logIndex uint64) string {
+	return key + FormatIntToKey(blockNumber) + FormatIntToKey(transactionIndex) + FormatIntToKey(logIndex)
+}
+
+// AddLogsToIndexes saves logs of the block to the indexes by contract address and topic0 to make them searchable
+func AddLogsToIndexes(b Batch, blockNumber, timestamp uint64, logs []models.EventLog) {
+	for _, l := range logs {
+		indexed := models.IndexedEventLog{
+			Address:          l.Address,
+			BlockNumber:      blockNumber,
+			Data:             l.Data,
+			LogIndex:         l.LogIndex,
+			Params:           l.Params,
+			Removed:          l.Removed,
+			Timestamp:        timestamp,
+			Topics:           l.Topics,
+			TransactionHash:  l.TransactionHash,
+			TransactionIndex: l.TransactionIndex,
+		}
+		// As the same data is saved with a different keys, it is better to serialize it only once
+		data, err := rlp.EncodeToBytes(indexed)
+		if err != nil {
+			log.WithError(err).WithField("hash", l.TransactionHash).Fatal("Failed to encode log")
+		}
+		b.AddSerializedSingleKey(AddressLog{}, data, FormatLogKey(l.Address, blockNumber, l.TransactionIndex, l.LogIndex))
+		if len(l.Topics) > 0 {
+			b.AddSerializedSingleKey(TopicLog{}, data, FormatLogKey(l.Topics[0], blockNumber, l.TransactionIndex, l.LogIndex))
+		}
+	}
+}
+
+func (f *LogsFilter) matches(l *models.IndexedEventLog) bool {
+	if f.Address != nil && !strings.EqualFold(l.Address, *f.Address) {
+		return false
+	}
+	topics := []*string{f.Topic0, f.Topic1}
+	for i, topic := range topics {
+		if topic == nil {
+			continue
+		}
+		if len(l.Topics) <= i || !strings.EqualFold(l.Topics[i], *topic) {
+			return false
+		}
+	}
+	return true
+}
+
+// GetLogsPage returns logs matching the filter in ascending order. Logs are selected by address index if address is specified and by topic0 index otherwise
+func GetLogsPage(s Storage, filter LogsFilter, from, count uint64) (ret *models.LogsPaginatedResponse) {
+	var o interface{}
+	var key string
+	if filter.Address != nil {
+		o, key = new(AddressLog), *filter.Address
+	} else if filter.Topic0 != nil {
+		o, key = new(TopicLog), *filter.Topic0
+	} else {
+		log.Fatal("GetLogsPage: address or topic0 should be specified")
+	}
+
+	ret = &models.LogsPaginatedResponse{Start: from, Data: make([]models.IndexedEventLog, 0, count)}
+	skipped := uint64(0)
+	s.ForEach(o, key, &filter.FromBlock, func(_, res []byte) (stop bool) {
+		var l models.IndexedEventLog
+		err := rlp.DecodeBytes(res, &l)
+		if err != nil {
+			log.WithError(err).Fatal("Error decoding log from db")
+		}
+		if l.BlockNumber > filter.ToBlock {
+			return true
+		}
+		if !filter.matches(&l) {
+			return false
+		}
+		if skipped < from {
+			skipped++
+			return false
+		}
+		if uint64(len(ret.Data)) == count {
+			ret.HasNext = true
+			return true
+		}
+		ret.Data = append(ret.Data, l)
+		return false
+	})
+	ret.End = from + uint64(len(ret.Data))
+	return
+}
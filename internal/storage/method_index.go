@@ -0,0 +1,125 @@
+package storage
+
+import (
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/dailycrypto-me/daily-indexer/models"
+	"github.com/ethereum/go-ethereum/rlp"
+	log "github.com/sirupsen/logrus"
+)
+
+// MethodIndexMutex is held by the indexer while the block is processed and by the reindexing of the stored contract calls,
+// so they don't update counters of the method calls at the same time
+var MethodIndexMutex sync.Mutex
+
+// MethodTransaction is used to select prefix of the contract calls index. Calls are saved with GetMethodKey and the sequential index of the method call
+type MethodTransaction Transaction
+
+// MethodCount is the number of calls of the contract method
+type MethodCount struct {
+	Method string
+	Count  uint64
+}
+
+// ContractMethods keeps number of calls of every called method of the contract
+type ContractMethods struct {
+	Address string
+	Methods []MethodCount
+}
+
+// GetMethodKey returns key of the contract method calls index
+func GetMethodKey(contract, method string) string {
+	return strings.ToLower(contract + method)
+}
+
+// AddCall increments counter of the method calls and returns it to be used as the index key
+func (c *ContractMethods) AddCall(method string) uint64 {
+	for i := range c.Methods {
+		if c.Methods[i].Method == method {
+			c.Methods[i].Count++
+			return c.Methods[i].Count
+		}
+	}
+	c.Methods = append(c.Methods, MethodCount{Method: method, Count: 1})
+	return 1
+}
+
+// GetCount returns number of the method calls
+func (c *ContractMethods) GetCount(method string) uint64 {
+	for _, m := range c.Methods {
+		if m.Method == method {
+			return m.Count
+		}
+	}
+	return 0
+}
+
+// ToModel returns methods sorted by the number of calls
+func (c *ContractMethods) ToModel() models.ContractMethodsResponse {
+	ret := models.ContractMethodsResponse{Address: c.Address, Data: make([]models.MethodCount, 0, len(c.Methods))}
+	for _, m := range c.Methods {
+		ret.Data = append(ret.Data, models.MethodCount{Method: m.Method, Count: m.Count})
+	}
+	sort.SliceStable(ret.Data, func(i, j int) bool {
+		return ret.Data[i].Count > ret.Data[j].Count
+	})
+	return ret
+}
+
+type ContractMethodsMap struct {
+	m       sync.Mutex
+	methods map[string]*ContractMethods
+}
+
+func MakeContractMethodsMap() *ContractMethodsMap {
+	return &ContractMethodsMap{methods: make(map[string]*ContractMethods)}
+}
+
+// AddCall increments the method calls counter of the contract. Counters are loaded from the storage on the first call in the block
+func (c *ContractMethodsMap) AddCall(s Storage, contract, method string) uint64 {
+	contract = strings.ToLower(contract)
+	c.m.Lock()
+	defer c.m.Unlock()
+	methods := c.methods[contract]
+	if methods == nil {
+		methods = s.GetContractMethods(contract)
+		c.methods[contract] = methods
+	}
+	return methods.AddCall(method)
+}
+
+func (c *ContractMethodsMap) AddToBatch(b Batch) {
+	for address, methods := range c.methods {
+		b.AddSingleKey(methods, address)
+	}
+}
+
+// GetMethodTransactionsPage returns calls of the contract method starting from the latest one
+func GetMethodTransactionsPage(s Storage, contract, method string, from, count uint64) (ret []Transaction, pagination *models.PaginatedResponse) {
+	pagination = new(models.PaginatedResponse)
+	pagination.Start = from
+	pagination.Total = s.GetContractMethods(contract).GetCount(method)
+	end := from + count
+	pagination.HasNext = (end < pagination.Total)
+	if end > pagination.Total {
+		end = pagination.Total
+	}
+	pagination.End = end
+	ret = make([]Transaction, 0, count)
+	if from >= pagination.Total {
+		return
+	}
+	start := pagination.Total - from
+	s.ForEachBackwards(new(MethodTransaction), GetMethodKey(contract, method), &start, func(_, res []byte) (stop bool) {
+		var o Transaction
+		err := rlp.DecodeBytes(res, &o)
+		if err != nil {
+			log.WithError(err).Fatal("Error decoding method transaction from db")
+		}
+		ret = append(ret, o)
+		return uint64(len(ret)) == count
+	})
+	return
+}
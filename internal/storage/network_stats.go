@@ -0,0 +1,144 @@
+package storage
+
+import (
+	"math/big"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/dailycrypto-me/daily-indexer/models"
+	"github.com/ethereum/go-ethereum/rlp"
+	log "github.com/sirupsen/logrus"
+)
+
+// NetworkStats is the network activity aggregated in the time window. Transactions count, fees, value and senders include top-level transactions only.
+// New addresses are counted on their first transaction including internal ones
+type NetworkStats struct {
+	Start             uint64
+	TransactionsCount uint64
+	DagsCount         uint64
+	PbftCount         uint64
+	Fees              *big.Int
+	ActiveSenders     uint64
+	NewAddresses      uint64
+	Value             *big.Int
+}
+
+// DailyNetworkStats is used to select prefix of the network stats aggregated by UTC days
+type DailyNetworkStats NetworkStats
+
+// MonthlyNetworkStats is used to select prefix of the network stats aggregated by UTC months
+type MonthlyNetworkStats NetworkStats
+
+// SenderActivity is the timestamp of the last transaction sent from the address. It is used to count unique senders in the windows
+type SenderActivity struct {
+	Timestamp uint64
+}
+
+func MakeNetworkStats(start uint64) NetworkStats {
+	return NetworkStats{Start: start, Fees: big.NewInt(0), Value: big.NewInt(0)}
+}
+
+func (n *NetworkStats) add(o *NetworkStats) {
+	n.TransactionsCount += o.TransactionsCount
+	n.DagsCount += o.DagsCount
+	n.PbftCount += o.PbftCount
+	n.Fees.Add(n.Fees, o.Fees)
+	n.NewAddresses += o.NewAddresses
+	n.Value.Add(n.Value, o.Value)
+}
+
+func (n *NetworkStats) ToModel() models.NetworkStats {
+	return models.NetworkStats{
+		Start:             n.Start,
+		TransactionsCount: n.TransactionsCount,
+		DagsCount:         n.DagsCount,
+		PbftCount:         n.PbftCount,
+		Fees:              n.Fees.String(),
+		ActiveSenders:     n.ActiveSenders,
+		NewAddresses:      n.NewAddresses,
+		Value:             n.Value.String(),
+	}
+}
+
+// GetMonthStart returns timestamp of the UTC month start
+func GetMonthStart(timestamp uint64) uint64 {
+	t := time.Unix(int64(timestamp), 0).UTC()
+	return uint64(time.Date(t.Year(), t.Month(), 1, 0, 0, 0, 0, time.UTC).Unix())
+}
+
+// BlockNetworkStats collects network activity of the block that is added to the daily and monthly stats
+type BlockNetworkStats struct {
+	m       sync.Mutex
+	stats   NetworkStats
+	senders map[string]bool
+}
+
+func MakeBlockNetworkStats() *BlockNetworkStats {
+	return &BlockNetworkStats{stats: MakeNetworkStats(0), senders: make(map[string]bool)}
+}
+
+// AddTransaction adds transaction to the stats. Value should be nil for failed transactions
+func (b *BlockNetworkStats) AddTransaction(from string, fee, value *big.Int) {
+	b.m.Lock()
+	defer b.m.Unlock()
+	b.stats.TransactionsCount++
+	b.stats.Fees.Add(b.stats.Fees, fee)
+	if value != nil {
+		b.stats.Value.Add(b.stats.Value, value)
+	}
+	b.senders[strings.ToLower(from)] = true
+}
+
+// AddNewAddress counts address that got its first transaction in the block
+func (b *BlockNetworkStats) AddNewAddress() {
+	b.m.Lock()
+	defer b.m.Unlock()
+	b.stats.NewAddresses++
+}
+
+// AddToBatch adds block stats to the daily and monthly windows that contain the block timestamp
+func (b *BlockNetworkStats) AddToBatch(s Storage, batch Batch, timestamp, dagsCount uint64) {
+	b.m.Lock()
+	defer b.m.Unlock()
+	b.stats.PbftCount = 1
+	b.stats.DagsCount = dagsCount
+
+	dayStart, monthStart := GetDayStart(timestamp), GetMonthStart(timestamp)
+	daily := s.GetNetworkStats(new(DailyNetworkStats), dayStart)
+	daily.add(&b.stats)
+	monthly := s.GetNetworkStats(new(MonthlyNetworkStats), monthStart)
+	monthly.add(&b.stats)
+
+	for sender := range b.senders {
+		last := s.GetSenderActivity(sender).Timestamp
+		if last < dayStart {
+			daily.ActiveSenders++
+		}
+		if last < monthStart {
+			monthly.ActiveSenders++
+		}
+		batch.AddSingleKey(&SenderActivity{Timestamp: timestamp}, sender)
+	}
+
+	batch.Add(DailyNetworkStats(daily), "", dayStart)
+	batch.Add(MonthlyNetworkStats(monthly), "", monthStart)
+}
+
+// GetNetworkStatsSeries returns network stats for windows started in the [from, to] range. o selects daily or monthly stats
+func GetNetworkStatsSeries(s Storage, o interface{}, from, to uint64) []models.NetworkStats {
+	ret := make([]models.NetworkStats, 0)
+	s.ForEach(o, "", &from, func(_, res []byte) (stop bool) {
+		var stats NetworkStats
+		err := rlp.DecodeBytes(res, &stats)
+		if err != nil {
+			log.WithError(err).Fatal("Error decoding network stats from db")
+		}
+		if stats.Start > to {
+			return true
+		}
+		ret = append(ret, stats.ToModel())
+		return false
+	})
+	return ret
+}
@@ -31,6 +31,13 @@ func (b *Batch) SetTotalSupply(s *storage.TotalSupply) {
 	}
 }
 
+func (b *Batch) SetSupplyStats(s *storage.SupplyStats) {
+	err := b.AddWithKey(s, []byte(GetPrefix(s)))
+	if err != nil {
+		log.WithError(err).Fatal("SetSupplyStats failed")
+	}
+}
+
 func (b *Batch) SetFinalizationData(f *storage.FinalizationData) {
 	err := b.AddWithKey(f, []byte(GetPrefix(f)))
 	if err != nil {
@@ -109,3 +116,7 @@ func (b *Batch) Remove(key []byte) {
 		log.WithError(err).Fatal("Remove failed")
 	}
 }
+
+func (b *Batch) RemoveSingleKey(o interface{}, key string) {
+	b.Remove(GetPrefixKey(GetPrefix(o), key))
+}
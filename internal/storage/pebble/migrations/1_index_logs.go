@@ -0,0 +1,55 @@
+package migration
+
+import (
+	"github.com/dailycrypto-me/daily-indexer/internal/storage"
+	"github.com/dailycrypto-me/daily-indexer/internal/storage/pebble"
+	"github.com/dailycrypto-me/daily-indexer/models"
+	"github.com/ethereum/go-ethereum/rlp"
+	log "github.com/sirupsen/logrus"
+)
+
+const logsBatchLimit = 10000
+
+// IndexLogs is a migration that adds already indexed transaction logs to the indexes by contract address and topic0
+type IndexLogs struct {
+	id string
+}
+
+func (m *IndexLogs) GetId() string {
+	return m.id
+}
+
+// Apply is the implementation of the Migration interface for the IndexLogs.
+func (m *IndexLogs) Apply(s *pebble.Storage) error {
+	prefix := pebble.GetPrefix(models.TransactionLogsResponse{})
+	b := s.NewBatch()
+	count, total := 0, 0
+	s.ForEach(new(models.TransactionLogsResponse), "", nil, func(key, res []byte) (stop bool) {
+		var logs models.TransactionLogsResponse
+		err := rlp.DecodeBytes(res, &logs)
+		if err != nil {
+			log.WithError(err).Fatal("IndexLogs: Error decoding logs")
+		}
+		hash := string(key[len(prefix):])
+		trx := s.GetTransactionByHash(hash)
+		if trx.Hash == "" {
+			log.WithField("hash", hash).Warn("IndexLogs: Transaction not found")
+			return false
+		}
+		storage.AddLogsToIndexes(b, trx.BlockNumber, trx.Timestamp, logs.Data)
+
+		count += len(logs.Data)
+		if count >= logsBatchLimit {
+			b.CommitBatch()
+			b = s.NewBatch()
+			total += count
+			count = 0
+			log.WithField("logs", total).Info("IndexLogs: Indexed logs")
+		}
+		return false
+	})
+	b.CommitBatch()
+	log.WithField("logs", total+count).Info("IndexLogs: Finished indexing logs")
+
+	return nil
+}
@@ -0,0 +1,42 @@
+package migration
+
+import (
+	"github.com/dailycrypto-me/daily-indexer/internal/chain"
+	"github.com/dailycrypto-me/daily-indexer/internal/common"
+	"github.com/dailycrypto-me/daily-indexer/internal/storage"
+	"github.com/dailycrypto-me/daily-indexer/internal/storage/pebble"
+	"github.com/dailycrypto-me/daily-indexer/models"
+)
+
+// IndexDelegations is a migration that builds delegations from genesis and already indexed DPOS contract logs
+type IndexDelegations struct {
+	id            string
+	blockchain_ws string
+}
+
+func (m *IndexDelegations) GetId() string {
+	return m.id
+}
+
+// Apply is the implementation of the Migration interface for the IndexDelegations.
+func (m *IndexDelegations) Apply(s *pebble.Storage) error {
+	d := storage.MakeDelegationsMap()
+	replay := dposLogsReplay{
+		name: "IndexDelegations",
+		genesis: func(genesis chain.GenesisObject) {
+			for _, validator := range genesis.Dpos.InitialValidators {
+				for addr, value := range validator.Delegations {
+					d.AddDelegation(s, addr, validator.Address, common.ParseStringToBigInt(value), 0)
+				}
+			}
+		},
+		update: func(l models.IndexedEventLog, eventLog models.EventLog) error {
+			return d.UpdateEvents(s, l.BlockNumber, l.Timestamp, []models.EventLog{eventLog})
+		},
+		flush: func(b storage.Batch) {
+			d.AddToBatch(b)
+			d = storage.MakeDelegationsMap()
+		},
+	}
+	return replay.apply(s, m.blockchain_ws)
+}
@@ -0,0 +1,40 @@
+package migration
+
+import (
+	"github.com/dailycrypto-me/daily-indexer/internal/chain"
+	"github.com/dailycrypto-me/daily-indexer/internal/storage"
+	"github.com/dailycrypto-me/daily-indexer/internal/storage/pebble"
+	"github.com/dailycrypto-me/daily-indexer/models"
+)
+
+// IndexValidatorProfiles is a migration that builds validator profiles from genesis and already indexed DPOS contract logs
+type IndexValidatorProfiles struct {
+	id            string
+	blockchain_ws string
+}
+
+func (m *IndexValidatorProfiles) GetId() string {
+	return m.id
+}
+
+// Apply is the implementation of the Migration interface for the IndexValidatorProfiles.
+func (m *IndexValidatorProfiles) Apply(s *pebble.Storage) error {
+	p := storage.MakeValidatorProfilesMap()
+	replay := dposLogsReplay{
+		name: "IndexValidatorProfiles",
+		genesis: func(genesis chain.GenesisObject) {
+			for _, validator := range genesis.Dpos.InitialValidators {
+				p.AddProfile(s, validator.ToValidatorEvent(), 0, genesis.DagGenesisBlock.Timestamp)
+			}
+		},
+		update: func(l models.IndexedEventLog, eventLog models.EventLog) error {
+			tx := s.GetTransactionByHash(l.TransactionHash)
+			return p.UpdateEvents(s, l.BlockNumber, l.Timestamp, tx.From, tx.Input, []models.EventLog{eventLog})
+		},
+		flush: func(b storage.Batch) {
+			p.AddToBatch(b)
+			p = storage.MakeValidatorProfilesMap()
+		},
+	}
+	return replay.apply(s, m.blockchain_ws)
+}
@@ -0,0 +1,46 @@
+package migration
+
+import (
+	"strconv"
+
+	"github.com/dailycrypto-me/daily-indexer/internal/storage"
+	"github.com/dailycrypto-me/daily-indexer/internal/storage/pebble"
+	"github.com/ethereum/go-ethereum/rlp"
+	log "github.com/sirupsen/logrus"
+)
+
+// IndexValidatorWeeks is a migration that adds already saved week stats to the weekly stats index by validator.
+// Yields of the past weeks aren't known here, so they are left empty
+type IndexValidatorWeeks struct {
+	id string
+}
+
+func (m *IndexValidatorWeeks) GetId() string {
+	return m.id
+}
+
+// Apply is the implementation of the Migration interface for the IndexValidatorWeeks.
+func (m *IndexValidatorWeeks) Apply(s *pebble.Storage) error {
+	prefix := pebble.GetPrefix(storage.WeekStats{})
+	b := s.NewBatch()
+	count := 0
+	s.ForEach(new(storage.WeekStats), "", nil, func(key, res []byte) (stop bool) {
+		var stats storage.WeekStats
+		err := rlp.DecodeBytes(res, &stats)
+		if err != nil {
+			log.WithError(err).Fatal("IndexValidatorWeeks: Error decoding week stats")
+		}
+		yearWeek, err := strconv.ParseUint(string(key[len(prefix):]), 10, 64)
+		if err != nil {
+			log.WithError(err).WithField("key", string(key)).Warn("IndexValidatorWeeks: Unexpected week stats key")
+			return false
+		}
+		storage.AddWeekToValidatorsIndex(b, &stats, int32(yearWeek/100), int32(yearWeek%100), nil)
+		count++
+		return false
+	})
+	b.CommitBatch()
+	log.WithField("weeks", count).Info("IndexValidatorWeeks: Finished processing week stats")
+
+	return nil
+}
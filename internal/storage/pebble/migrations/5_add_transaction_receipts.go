@@ -0,0 +1,213 @@
+package migration
+
+import (
+	"bytes"
+	"math/big"
+	"sync"
+
+	"github.com/dailycrypto-me/daily-indexer/internal/chain"
+	"github.com/dailycrypto-me/daily-indexer/internal/common"
+	"github.com/dailycrypto-me/daily-indexer/internal/storage"
+	"github.com/dailycrypto-me/daily-indexer/internal/storage/pebble"
+	"github.com/dailycrypto-me/daily-indexer/models"
+	"github.com/ethereum/go-ethereum/rlp"
+	log "github.com/sirupsen/logrus"
+)
+
+// AddTransactionReceipts is a migration that backfills receipt fields of the transaction records saved before storage.TransactionVersion
+type AddTransactionReceipts struct {
+	id            string
+	blockchain_ws string
+}
+
+func (m *AddTransactionReceipts) GetId() string {
+	return m.id
+}
+
+// Apply is the implementation of the Migration interface for the AddTransactionReceipts.
+func (m *AddTransactionReceipts) Apply(s *pebble.Storage) error {
+	if s.GetFinalizationData().PbftCount == 0 {
+		log.Info("AddTransactionReceipts: Skipping migration as nothing was indexed yet")
+		return nil
+	}
+	client, err := chain.NewWsClient(m.blockchain_ws)
+	if err != nil {
+		log.Fatal(err)
+	}
+	defer client.Close()
+
+	return backfillReceipts(s, client)
+}
+
+// receiptsBatch is the set of records that are updated with the receipts requested in one go
+type receiptsBatch[T any] struct {
+	keys    [][]byte
+	records []T
+	hashes  []string
+	total   int
+}
+
+func (r *receiptsBatch[T]) add(key []byte, record T, hash string) {
+	r.keys = append(r.keys, append([]byte{}, key...))
+	r.records = append(r.records, record)
+	r.hashes = append(r.hashes, hash)
+}
+
+// commit gets receipts of the batch transactions, updates records with them and saves them under the same keys. Records without receipt are skipped
+func (r *receiptsBatch[T]) commit(s *pebble.Storage, getReceipts func(hashes []string) map[string]*chain.Transaction, update func(*T, *chain.Transaction)) error {
+	receipts := getReceipts(r.hashes)
+	b := s.NewBatch()
+	for i := range r.records {
+		receipt := receipts[r.hashes[i]]
+		if receipt == nil {
+			continue
+		}
+		update(&r.records[i], receipt)
+		err := b.AddWithKey(r.records[i], r.keys[i])
+		if err != nil {
+			return err
+		}
+		r.total++
+	}
+	b.CommitBatch()
+
+	r.keys, r.records, r.hashes = r.keys[:0], r.records[:0], r.hashes[:0]
+	return nil
+}
+
+// fetchReceipts concurrently requests transactions with the receipt data. Every transaction is requested once.
+// Transactions that failed to be fetched are logged and left without receipt, so the migration isn't blocked by them
+func fetchReceipts(client chain.Client, hashes []string) map[string]*chain.Transaction {
+	receipts := make(map[string]*chain.Transaction, len(hashes))
+	for _, hash := range hashes {
+		receipts[hash] = nil
+	}
+	var mutex sync.Mutex
+	tp := common.MakeThreadPool()
+	for hash := range receipts {
+		tp.Go(func() {
+			trx, err := client.GetTransactionByHash(hash)
+			if err != nil || trx.Hash == "" {
+				log.WithError(err).WithField("hash", hash).Warn("AddTransactionReceipts: Failed to fetch transaction, it is skipped")
+				return
+			}
+			mutex.Lock()
+			defer mutex.Unlock()
+			receipts[hash] = &trx
+		})
+	}
+	tp.Wait()
+	return receipts
+}
+
+// storedReceipts returns receipt fields of the transactions saved by hash that were already updated
+func storedReceipts(s *pebble.Storage, hashes []string) map[string]*chain.Transaction {
+	receipts := make(map[string]*chain.Transaction, len(hashes))
+	for _, hash := range hashes {
+		if _, ok := receipts[hash]; ok {
+			continue
+		}
+		trx := s.GetTransactionByHash(hash)
+		if trx.Version < storage.TransactionVersion {
+			receipts[hash] = nil
+			continue
+		}
+		receipts[hash] = &chain.Transaction{Nonce: trx.Nonce, GasPrice: trx.GasPrice, GasUsed: trx.GasUsed, TransactionIndex: trx.TransactionIndex, ContractAddress: trx.ContractAddress}
+	}
+	return receipts
+}
+
+func updateTransaction(trx *storage.Transaction, receipt *chain.Transaction) {
+	trx.Nonce = receipt.Nonce
+	trx.GasPrice = receipt.GasPrice
+	trx.TransactionIndex = receipt.TransactionIndex
+	trx.Version = storage.TransactionVersion
+	if !trx.IsInternal() {
+		trx.GasUsed = receipt.GasUsed
+		trx.ContractAddress = receipt.ContractAddress
+		return
+	}
+	// gas used by the internal transaction is only available in traces, but it can be restored from the gas cost
+	if trx.GasPrice > 0 && trx.GasCost != nil {
+		trx.GasUsed = big.NewInt(0).Div(trx.GasCost, big.NewInt(0).SetUint64(trx.GasPrice)).Uint64()
+	}
+	if trx.Type == models.InternalContractCreation {
+		trx.ContractAddress = trx.To
+	}
+}
+
+// backfillReceipts requests receipts only for the transactions saved by hash. Copies in the address index and internal transactions
+// are updated from the already updated records, so every transaction is requested once
+func backfillReceipts(s *pebble.Storage, client chain.Client) (err error) {
+	prefix := pebble.GetPrefix(storage.Transaction{})
+	fromClient := func(hashes []string) map[string]*chain.Transaction { return fetchReceipts(client, hashes) }
+	fromStorage := func(hashes []string) map[string]*chain.Transaction { return storedReceipts(s, hashes) }
+
+	// iterate returns records of the old version, byHash selects records saved by the transaction hash or the copies in the address index
+	iterate := func(batch *receiptsBatch[storage.Transaction], byHash bool, getReceipts func([]string) map[string]*chain.Transaction) {
+		s.ForEach(new(storage.Transaction), "", nil, func(key, res []byte) (stop bool) {
+			var trx storage.Transaction
+			err = rlp.DecodeBytes(res, &trx)
+			if err != nil {
+				log.WithError(err).Fatal("AddTransactionReceipts: Error decoding transaction")
+			}
+			if trx.Version >= storage.TransactionVersion || bytes.Equal(key, pebble.GetPrefixKey(prefix, trx.Hash)) != byHash {
+				return false
+			}
+			batch.add(key, trx, trx.Hash)
+			if len(batch.records) >= logsBatchLimit {
+				err = batch.commit(s, getReceipts, updateTransaction)
+				log.WithField("transactions", batch.total).Info("AddTransactionReceipts: Updated transactions")
+			}
+			return err != nil
+		})
+		if err == nil {
+			err = batch.commit(s, getReceipts, updateTransaction)
+		}
+	}
+
+	transactions := new(receiptsBatch[storage.Transaction])
+	iterate(transactions, true, fromClient)
+	if err != nil {
+		return
+	}
+	log.WithField("transactions", transactions.total).Info("AddTransactionReceipts: Finished updating transactions")
+
+	copies := new(receiptsBatch[storage.Transaction])
+	iterate(copies, false, fromStorage)
+	if err != nil {
+		return
+	}
+	log.WithField("records", copies.total).Info("AddTransactionReceipts: Finished updating address index")
+
+	updateInternal := func(internal *storage.InternalTransactionsResponse, receipt *chain.Transaction) {
+		for i := range internal.Data {
+			updateTransaction(&internal.Data[i], receipt)
+		}
+	}
+	internals := new(receiptsBatch[storage.InternalTransactionsResponse])
+	s.ForEach(new(storage.InternalTransactionsResponse), "", nil, func(key, res []byte) (stop bool) {
+		var internal storage.InternalTransactionsResponse
+		err = rlp.DecodeBytes(res, &internal)
+		if err != nil {
+			log.WithError(err).Fatal("AddTransactionReceipts: Error decoding internal transactions")
+		}
+		if len(internal.Data) == 0 || internal.Data[0].Version >= storage.TransactionVersion {
+			return false
+		}
+		internals.add(key, internal, internal.Data[0].Hash)
+		if len(internals.records) >= logsBatchLimit {
+			err = internals.commit(s, fromStorage, updateInternal)
+		}
+		return err != nil
+	})
+	if err != nil {
+		return
+	}
+	err = internals.commit(s, fromStorage, updateInternal)
+	if err != nil {
+		return
+	}
+	log.WithField("hashes", internals.total).Info("AddTransactionReceipts: Finished updating internal transactions")
+	return
+}
@@ -0,0 +1,104 @@
+package migration
+
+import (
+	"errors"
+	"math/big"
+	"sync/atomic"
+	"testing"
+
+	"github.com/dailycrypto-me/daily-indexer/internal/chain"
+	"github.com/dailycrypto-me/daily-indexer/internal/storage"
+	"github.com/dailycrypto-me/daily-indexer/internal/storage/pebble"
+	"github.com/dailycrypto-me/daily-indexer/models"
+	"github.com/ethereum/go-ethereum/rlp"
+	"github.com/stretchr/testify/assert"
+)
+
+// legacyTransaction is the transaction record format before receipt fields were added
+type legacyTransaction struct {
+	BlockNumber uint64
+	Calldata    *models.CallData `rlp:"nil"`
+	From        string
+	GasCost     *big.Int
+	Hash        string
+	Input       string
+	Status      bool
+	Timestamp   uint64
+	To          string
+	Type        models.TransactionType
+	Value       *big.Int
+}
+
+// countingClient counts transaction requests and fails for the unknown transactions
+type countingClient struct {
+	*chain.ClientMock
+	requests atomic.Int32
+}
+
+func (c *countingClient) GetTransactionByHash(hash string) (chain.Transaction, error) {
+	c.requests.Add(1)
+	trx, ok := c.Transactions[hash]
+	if !ok {
+		return trx, errors.New("not found")
+	}
+	return trx, nil
+}
+
+func TestBackfillReceipts(t *testing.T) {
+	st := pebble.NewStorage("")
+	defer st.Close()
+
+	hash := "0x0000000000000000000000000000000000000000000000000000000000000001"
+	from, to := "0x0000000000000000000000000000000000000001", "0x0000000000000000000000000000000000000002"
+	trx := legacyTransaction{BlockNumber: 10, From: from, GasCost: big.NewInt(42000), Hash: hash, Status: true, Timestamp: 100, To: to, Type: models.ContractCall, Value: big.NewInt(1)}
+	internal := legacyTransaction{BlockNumber: 10, From: to, GasCost: big.NewInt(200), Hash: hash, Status: true, Timestamp: 100, To: from, Type: models.InternalTransfer, Value: big.NewInt(1)}
+
+	prefix := pebble.GetPrefix(storage.Transaction{})
+	b := st.NewBatch()
+	assert.NoError(t, b.AddWithKey(trx, pebble.GetPrefixKey(prefix, hash)))
+	assert.NoError(t, b.AddWithKey(trx, pebble.GetPrefixKey(prefix, from+storage.FormatIntToKey(1))))
+	assert.NoError(t, b.AddWithKey(struct{ Data []legacyTransaction }{Data: []legacyTransaction{internal}}, pebble.GetPrefixKey(pebble.GetPrefix(storage.InternalTransactionsResponse{}), hash)))
+	// transaction that can't be fetched is skipped
+	failed := legacyTransaction{BlockNumber: 11, From: from, GasCost: big.NewInt(1), Hash: "0x02", Timestamp: 100, Value: big.NewInt(0)}
+	assert.NoError(t, b.AddWithKey(failed, pebble.GetPrefixKey(prefix, failed.Hash)))
+	assert.NoError(t, b.AddWithKey(failed, pebble.GetPrefixKey(prefix, from+storage.FormatIntToKey(2))))
+	b.CommitBatch()
+
+	// legacy records are decoded without receipt fields
+	saved := st.GetTransactionByHash(hash)
+	assert.Equal(t, uint8(0), saved.Version)
+	assert.Equal(t, uint64(0), saved.GasUsed)
+
+	client := &countingClient{ClientMock: chain.MakeMockClient()}
+	client.Transactions[hash] = chain.Transaction{Transaction: storage.Transaction{Hash: hash}, Nonce: 5, GasPrice: 2, GasUsed: 21000, TransactionIndex: 3}
+	assert.NoError(t, backfillReceipts(st, client))
+	// every transaction is requested once, copies are updated from the stored record
+	assert.Equal(t, int32(2), client.requests.Load())
+	assert.Equal(t, uint8(0), st.GetTransactionByHash(failed.Hash).Version)
+
+	saved = st.GetTransactionByHash(hash)
+	assert.Equal(t, uint8(storage.TransactionVersion), saved.Version)
+	assert.Equal(t, uint64(5), saved.Nonce)
+	assert.Equal(t, uint64(2), saved.GasPrice)
+	assert.Equal(t, uint64(21000), saved.GasUsed)
+	assert.Equal(t, uint64(3), saved.TransactionIndex)
+	assert.Equal(t, "42000", saved.GasCost.String())
+
+	// records in the address index are updated as well
+	indexed := make([]storage.Transaction, 0)
+	st.ForEach(new(storage.Transaction), from, nil, func(_, res []byte) (stop bool) {
+		var o storage.Transaction
+		assert.NoError(t, rlp.DecodeBytes(res, &o))
+		indexed = append(indexed, o)
+		return false
+	})
+	assert.Len(t, indexed, 2)
+	assert.Equal(t, uint64(21000), indexed[0].GasUsed)
+	assert.Equal(t, uint8(0), indexed[1].Version)
+
+	internals := st.GetInternalTransactions(hash)
+	assert.Len(t, internals.Data, 1)
+	assert.Equal(t, uint64(5), internals.Data[0].Nonce)
+	assert.Equal(t, uint64(100), internals.Data[0].GasUsed)
+	assert.Equal(t, uint8(storage.TransactionVersion), internals.Data[0].Version)
+}
@@ -0,0 +1,55 @@
+package migration
+
+import (
+	"github.com/dailycrypto-me/daily-indexer/internal/chain"
+	"github.com/dailycrypto-me/daily-indexer/internal/common"
+	"github.com/dailycrypto-me/daily-indexer/internal/storage"
+	"github.com/dailycrypto-me/daily-indexer/internal/storage/pebble"
+	log "github.com/sirupsen/logrus"
+)
+
+// SaveSupplyStats is a migration that saves genesis supply and total delegated amount for the already indexed data
+type SaveSupplyStats struct {
+	id            string
+	blockchain_ws string
+}
+
+func (m *SaveSupplyStats) GetId() string {
+	return m.id
+}
+
+// Apply is the implementation of the Migration interface for the SaveSupplyStats.
+func (m *SaveSupplyStats) Apply(s *pebble.Storage) error {
+	block := s.GetFinalizationData().PbftCount
+	if block == 0 {
+		log.Info("SaveSupplyStats: Skipping migration as nothing was indexed yet")
+		return nil
+	}
+	client, err := chain.NewWsClient(m.blockchain_ws)
+	if err != nil {
+		log.Fatal(err)
+	}
+	defer client.Close()
+
+	genesis, err := client.GetGenesis()
+	if err != nil {
+		return err
+	}
+	supply := storage.MakeSupplyStats()
+	supply.Block = block
+	for _, value := range genesis.InitialBalances {
+		supply.GenesisSupply.Add(supply.GenesisSupply, common.ParseStringToBigInt(value))
+	}
+	delegated, err := client.GetTotalAmountDelegated(block)
+	if err != nil {
+		return err
+	}
+	supply.TotalDelegated = delegated
+
+	b := s.NewBatch()
+	b.SetSupplyStats(&supply)
+	b.CommitBatch()
+	log.WithFields(log.Fields{"genesis_supply": supply.GenesisSupply, "total_delegated": supply.TotalDelegated}).Info("SaveSupplyStats: Saved supply stats")
+
+	return nil
+}
@@ -0,0 +1,72 @@
+package migration
+
+import (
+	"github.com/dailycrypto-me/daily-indexer/internal/chain"
+	"github.com/dailycrypto-me/daily-indexer/internal/storage"
+	"github.com/dailycrypto-me/daily-indexer/internal/storage/pebble"
+	log "github.com/sirupsen/logrus"
+)
+
+// IndexSupplyHistory is a migration that saves supply snapshots for the already indexed blocks. Historical supply and delegations are requested from the node
+type IndexSupplyHistory struct {
+	id            string
+	blockchain_ws string
+	interval      uint64
+}
+
+func (m *IndexSupplyHistory) GetId() string {
+	return m.id
+}
+
+// Apply is the implementation of the Migration interface for the IndexSupplyHistory.
+func (m *IndexSupplyHistory) Apply(s *pebble.Storage) error {
+	last := s.GetFinalizationData().PbftCount
+	if last < m.interval || m.interval == 0 {
+		log.Info("IndexSupplyHistory: Skipping migration as there are no finished supply intervals")
+		return nil
+	}
+	client, err := chain.NewWsClient(m.blockchain_ws)
+	if err != nil {
+		log.Fatal(err)
+	}
+	defer client.Close()
+
+	return indexSupplyHistory(s, client, m.interval, last)
+}
+
+func indexSupplyHistory(s *pebble.Storage, client chain.Client, interval, last uint64) error {
+	previous := storage.GetPreviousSupply(s)
+	b := s.NewBatch()
+	count := 0
+	for block := interval; block <= last; block += interval {
+		pbft, err := client.GetBlockByNumber(block)
+		if err != nil {
+			return err
+		}
+		totalSupply, err := client.GetTotalSupply(block)
+		if err != nil {
+			return err
+		}
+		// supply isn't available on the networks without aspen hardfork
+		if totalSupply.Sign() == 0 {
+			continue
+		}
+		totalDelegated, err := client.GetTotalAmountDelegated(block)
+		if err != nil {
+			return err
+		}
+		snapshot := storage.MakeSupplySnapshot(block, pbft.Timestamp, totalSupply, totalDelegated, previous)
+		b.AddSingleKey(snapshot, storage.FormatIntToKey(block))
+		previous = snapshot.TotalSupply
+		count++
+		if count%1000 == 0 {
+			b.CommitBatch()
+			b = s.NewBatch()
+			log.WithField("block", block).Info("IndexSupplyHistory: Saved supply snapshots")
+		}
+	}
+	b.CommitBatch()
+	log.WithField("snapshots", count).Info("IndexSupplyHistory: Finished saving supply snapshots")
+
+	return nil
+}
@@ -0,0 +1,53 @@
+package migration
+
+import (
+	"math/big"
+
+	"github.com/dailycrypto-me/daily-indexer/internal/chain"
+	"github.com/dailycrypto-me/daily-indexer/internal/storage"
+	"github.com/dailycrypto-me/daily-indexer/internal/storage/pebble"
+	log "github.com/sirupsen/logrus"
+)
+
+// SeedValidatorSet is a migration that saves the validator set at the last indexed block of the database indexed before validator sets were saved.
+// Without it all validators would be recorded as joined at the first block indexed after the upgrade
+type SeedValidatorSet struct {
+	id            string
+	blockchain_ws string
+}
+
+func (m *SeedValidatorSet) GetId() string {
+	return m.id
+}
+
+// Apply is the implementation of the Migration interface for the SeedValidatorSet.
+func (m *SeedValidatorSet) Apply(s *pebble.Storage) error {
+	block := s.GetFinalizationData().PbftCount
+	if block == 0 {
+		log.Info("SeedValidatorSet: Skipping migration as nothing was indexed yet")
+		return nil
+	}
+	if last := s.GetLastValidatorSet(); len(last.Validators) > 0 {
+		log.Info("SeedValidatorSet: Skipping migration as validator set is already saved")
+		return nil
+	}
+	client, err := chain.NewWsClient(m.blockchain_ws)
+	if err != nil {
+		log.Fatal(err)
+	}
+	defer client.Close()
+
+	validators, err := client.GetValidatorsAtBlock(block)
+	if err != nil {
+		return err
+	}
+	stakes := make(map[string]*big.Int, len(validators))
+	for _, validator := range validators {
+		stakes[validator.Address] = validator.TotalStake
+	}
+	b := s.NewBatch()
+	set := storage.SeedValidatorSet(b, block, stakes)
+	b.CommitBatch()
+	log.WithFields(log.Fields{"block": block, "validators": len(set.Validators)}).Info("SeedValidatorSet: Saved validator set")
+	return nil
+}
@@ -0,0 +1,33 @@
+package migration
+
+import (
+	"github.com/dailycrypto-me/daily-indexer/internal/contracts"
+	"github.com/dailycrypto-me/daily-indexer/internal/storage"
+	"github.com/dailycrypto-me/daily-indexer/internal/storage/pebble"
+	"github.com/dailycrypto-me/daily-indexer/internal/transaction"
+	log "github.com/sirupsen/logrus"
+)
+
+// DecodeContractCalls is a migration that saves decoded calldata to the contract calls indexed before it was saved on indexing
+// and rebuilds the index of the contract method calls with their counters
+type DecodeContractCalls struct {
+	id string
+}
+
+func (m *DecodeContractCalls) GetId() string {
+	return m.id
+}
+
+// Apply is the implementation of the Migration interface for the DecodeContractCalls.
+func (m *DecodeContractCalls) Apply(s *pebble.Storage) error {
+	if s.GetFinalizationData().PbftCount == 0 {
+		log.Info("DecodeContractCalls: Skipping migration as nothing was indexed yet")
+		return nil
+	}
+	// migrations are applied before the stored ABIs are loaded
+	storage.LoadContractABIs(s, contracts.Abis)
+	storage.LoadSignatures(s, contracts.Signatures)
+
+	transaction.ReindexContractCalls(s, "")
+	return nil
+}
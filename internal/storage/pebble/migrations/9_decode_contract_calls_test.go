@@ -0,0 +1,81 @@
+package migration
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/dailycrypto-me/daily-indexer/internal/storage"
+	"github.com/dailycrypto-me/daily-indexer/internal/storage/pebble"
+	"github.com/dailycrypto-me/daily-indexer/internal/transaction"
+	"github.com/dailycrypto-me/daily-indexer/models"
+	"github.com/ethereum/go-ethereum/rlp"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDecodeContractCalls(t *testing.T) {
+	st := pebble.NewStorage("")
+	defer st.Close()
+
+	dpos := "0x00000000000000000000000000000000000000fe"
+	from1, from2 := "0x0000000000000000000000000000000000000001", "0x0000000000000000000000000000000000000002"
+	hash1, hash2 := "0x01", "0x02"
+	input := "0x5c19a95c000000000000000000000000ed4d5f4f3641cbc056e466d15dbe2403e38056f8"
+	call1 := storage.Transaction{Hash: hash1, From: from1, To: dpos, Input: input, Type: models.ContractCall, Value: big.NewInt(0), GasCost: big.NewInt(0)}
+	call2 := storage.Transaction{Hash: hash2, From: from2, To: dpos, Input: input, Type: models.ContractCall, Value: big.NewInt(0), GasCost: big.NewInt(0)}
+	selfCall := storage.Transaction{Hash: hash2, From: dpos, To: dpos, Input: input, Type: models.InternalContractCall, Value: big.NewInt(0), GasCost: big.NewInt(0)}
+	transfer := storage.Transaction{Hash: "0x03", From: from1, To: from2, Type: models.Transfer, Value: big.NewInt(1), GasCost: big.NewInt(0)}
+
+	prefix := pebble.GetPrefix(storage.Transaction{})
+	records := []struct {
+		key string
+		trx storage.Transaction
+	}{
+		{hash1, call1},
+		{hash2, call2},
+		{transfer.Hash, transfer},
+		{from1 + storage.FormatIntToKey(1), call1},
+		{from1 + storage.FormatIntToKey(2), transfer},
+		{from2 + storage.FormatIntToKey(1), call2},
+		{from2 + storage.FormatIntToKey(2), transfer},
+		{dpos + storage.FormatIntToKey(1), call1},
+		{dpos + storage.FormatIntToKey(2), call2},
+		{dpos + storage.FormatIntToKey(3), selfCall},
+		{dpos + storage.FormatIntToKey(4), selfCall},
+	}
+	b := st.NewBatch()
+	for _, r := range records {
+		assert.NoError(t, b.AddWithKey(r.trx, pebble.GetPrefixKey(prefix, r.key)))
+	}
+	b.AddSingleKey(storage.InternalTransactionsResponse{Data: []storage.Transaction{selfCall}}, hash2)
+	// stale counter is replaced by the rebuilt one
+	b.AddSingleKey(&storage.ContractMethods{Address: dpos, Methods: []storage.MethodCount{{Method: "delegate(address)", Count: 10}}}, dpos)
+	b.CommitBatch()
+
+	transaction.ReindexContractCalls(st, "")
+
+	method := "delegate(address)"
+	assert.Equal(t, uint64(3), st.GetContractMethods(dpos).GetCount(method))
+	page, _ := storage.GetMethodTransactionsPage(st, dpos, method, 0, 10)
+	assert.Len(t, page, 3)
+	assert.Equal(t, models.InternalContractCall, page[0].Type)
+	assert.Equal(t, hash2, page[1].Hash)
+	assert.Equal(t, hash1, page[2].Hash)
+
+	saved := st.GetTransactionByHash(hash1)
+	assert.Equal(t, method, saved.Method)
+	assert.Equal(t, []any{"0xed4d5f4f3641cbc056e466d15dbe2403e38056f8"}, saved.Calldata.Params)
+	assert.Nil(t, st.GetTransactionByHash(transfer.Hash).Calldata)
+
+	// copies in the address index are decoded as well
+	st.ForEach(new(storage.Transaction), from1, nil, func(_, res []byte) (stop bool) {
+		var trx storage.Transaction
+		assert.NoError(t, rlp.DecodeBytes(res, &trx))
+		assert.Equal(t, trx.Type == models.ContractCall, trx.Calldata != nil)
+		return false
+	})
+
+	internal := st.GetInternalTransactions(hash2)
+	assert.Len(t, internal.Data, 1)
+	assert.Equal(t, method, internal.Data[0].Method)
+	assert.NotNil(t, internal.Data[0].Calldata)
+}
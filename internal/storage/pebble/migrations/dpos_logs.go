@@ -0,0 +1,74 @@
+package migration
+
+import (
+	"github.com/dailycrypto-me/daily-indexer/internal/chain"
+	"github.com/dailycrypto-me/daily-indexer/internal/common"
+	"github.com/dailycrypto-me/daily-indexer/internal/storage"
+	"github.com/dailycrypto-me/daily-indexer/internal/storage/pebble"
+	"github.com/dailycrypto-me/daily-indexer/models"
+	"github.com/ethereum/go-ethereum/rlp"
+	log "github.com/sirupsen/logrus"
+)
+
+// dposLogsReplay rebuilds the state from genesis and already indexed DPOS contract logs.
+// flush should add the state to the batch and reset it, it is called every logsBatchLimit logs and after the last one
+type dposLogsReplay struct {
+	name    string
+	genesis func(genesis chain.GenesisObject)
+	update  func(l models.IndexedEventLog, eventLog models.EventLog) error
+	flush   func(b storage.Batch)
+}
+
+func (r *dposLogsReplay) apply(s *pebble.Storage, blockchain_ws string) error {
+	if s.GetFinalizationData().PbftCount == 0 {
+		log.Info(r.name + ": Skipping migration as nothing was indexed yet")
+		return nil
+	}
+	client, err := chain.NewWsClient(blockchain_ws)
+	if err != nil {
+		log.Fatal(err)
+	}
+	genesis, err := client.GetGenesis()
+	if err != nil {
+		return err
+	}
+	r.genesis(genesis)
+
+	count := 0
+	s.ForEach(new(storage.AddressLog), common.DposContractAddress, nil, func(_, res []byte) (stop bool) {
+		var l models.IndexedEventLog
+		err = rlp.DecodeBytes(res, &l)
+		if err != nil {
+			return true
+		}
+		eventLog := models.EventLog{
+			Address:          l.Address,
+			Data:             l.Data,
+			LogIndex:         l.LogIndex,
+			Topics:           l.Topics,
+			TransactionHash:  l.TransactionHash,
+			TransactionIndex: l.TransactionIndex,
+		}
+		err = r.update(l, eventLog)
+		if err != nil {
+			return true
+		}
+		count++
+		if count%logsBatchLimit == 0 {
+			b := s.NewBatch()
+			r.flush(b)
+			b.CommitBatch()
+			log.WithField("logs", count).Info(r.name + ": Processed DPOS logs")
+		}
+		return false
+	})
+	if err != nil {
+		return err
+	}
+	b := s.NewBatch()
+	r.flush(b)
+	b.CommitBatch()
+	log.WithField("logs", count).Info(r.name + ": Finished processing DPOS logs")
+
+	return nil
+}
@@ -1,6 +1,7 @@
 package migration
 
 import (
+	"github.com/dailycrypto-me/daily-indexer/internal/common"
 	"github.com/dailycrypto-me/daily-indexer/internal/storage/pebble"
 	log "github.com/sirupsen/logrus"
 )
@@ -17,11 +18,20 @@ type Manager struct {
 	migrations []Migration
 }
 
-func NewManager(s *pebble.Storage, blockchain_ws string) *Manager {
+func NewManager(s *pebble.Storage, blockchain_ws string, c *common.Config) *Manager {
 	m := Manager{
 		storage: s,
 	}
 	m.RegisterMigration(&FixDposBalance{id: "0_fix_dpos_balance", blockchain_ws: blockchain_ws})
+	m.RegisterMigration(&IndexLogs{id: "1_index_logs"})
+	m.RegisterMigration(&IndexDelegations{id: "2_index_delegations", blockchain_ws: blockchain_ws})
+	m.RegisterMigration(&IndexValidatorProfiles{id: "3_index_validator_profiles", blockchain_ws: blockchain_ws})
+	m.RegisterMigration(&IndexValidatorWeeks{id: "4_index_validator_weeks"})
+	m.RegisterMigration(&AddTransactionReceipts{id: "5_add_transaction_receipts", blockchain_ws: blockchain_ws})
+	m.RegisterMigration(&SaveSupplyStats{id: "6_save_supply_stats", blockchain_ws: blockchain_ws})
+	m.RegisterMigration(&IndexSupplyHistory{id: "7_index_supply_history", blockchain_ws: blockchain_ws, interval: c.SupplySavingInterval})
+	m.RegisterMigration(&SeedValidatorSet{id: "8_seed_validator_set", blockchain_ws: blockchain_ws})
+	m.RegisterMigration(&DecodeContractCalls{id: "9_decode_contract_calls"})
 	return &m
 }
 
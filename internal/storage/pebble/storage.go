@@ -35,6 +35,40 @@ const yieldPrefix = "y"
 const validatorsYieldPrefix = "vy"
 const multipliedYieldPrefix = "my"
 const RewardsStatsPrefix = "rs"
+const contractABIPrefix = "abi"
+const textSignaturePrefix = "sig"
+const addressLogsPrefix = "ea"
+const topicLogsPrefix = "et"
+const delegationPrefix = "dl"
+const validatorDelegationPrefix = "dv"
+const delegationEventPrefix = "dh"
+const undelegationPrefix = "du"
+const validatorProfilePrefix = "vp"
+const validatorEventPrefix = "ve"
+const validatorWeekStatsPrefix = "vw"
+const dailyVoteStatsPrefix = "vd"
+const weeklyVoteStatsPrefix = "vk"
+const periodRewardsPrefix = "pr"
+const validatorRewardsPrefix = "vr"
+const dailyNetworkStatsPrefix = "nd"
+const monthlyNetworkStatsPrefix = "nm"
+const senderActivityPrefix = "sa"
+const chainStatsSamplePrefix = "cs"
+const gasPriceStatsPrefix = "gp"
+const supplyStatsPrefix = "su"
+const supplySnapshotPrefix = "sh"
+const validatorSetPrefix = "vt"
+const validatorSetCheckpointPrefix = "vc"
+const validatorSetDiffPrefix = "vx"
+const validatorStakeChangePrefix = "vs"
+const weekValidatorSetPrefix = "vn"
+const dagProductionStatsPrefix = "dp"
+const dagGraphPrefix = "dg"
+const periodInclusionStatsPrefix = "ip"
+const dailyInclusionStatsPrefix = "id"
+const callTracePrefix = "tr"
+const methodTransactionPrefix = "mt"
+const contractMethodsPrefix = "cm"
 
 type Storage struct {
 	db   *pebble.DB
@@ -129,6 +163,74 @@ func GetPrefix(o interface{}) (ret string) {
 		ret = multipliedYieldPrefix
 	case *storage.RewardsStats, storage.RewardsStats:
 		ret = RewardsStatsPrefix
+	case *storage.ContractABI, storage.ContractABI:
+		ret = contractABIPrefix
+	case *storage.TextSignature, storage.TextSignature:
+		ret = textSignaturePrefix
+	case *storage.AddressLog, storage.AddressLog:
+		ret = addressLogsPrefix
+	case *storage.TopicLog, storage.TopicLog:
+		ret = topicLogsPrefix
+	case *storage.Delegation, storage.Delegation:
+		ret = delegationPrefix
+	case *storage.DelegationByValidator, storage.DelegationByValidator:
+		ret = validatorDelegationPrefix
+	case *storage.DelegationEvent, storage.DelegationEvent:
+		ret = delegationEventPrefix
+	case *storage.Undelegation, storage.Undelegation:
+		ret = undelegationPrefix
+	case *storage.ValidatorProfile, storage.ValidatorProfile:
+		ret = validatorProfilePrefix
+	case *storage.ValidatorEvent, storage.ValidatorEvent:
+		ret = validatorEventPrefix
+	case *storage.ValidatorWeekStats, storage.ValidatorWeekStats:
+		ret = validatorWeekStatsPrefix
+	case *storage.DailyVoteStats, storage.DailyVoteStats:
+		ret = dailyVoteStatsPrefix
+	case *storage.WeeklyVoteStats, storage.WeeklyVoteStats:
+		ret = weeklyVoteStatsPrefix
+	case *storage.PeriodRewards, storage.PeriodRewards:
+		ret = periodRewardsPrefix
+	case *storage.ValidatorRewards, storage.ValidatorRewards:
+		ret = validatorRewardsPrefix
+	case *storage.DailyNetworkStats, storage.DailyNetworkStats:
+		ret = dailyNetworkStatsPrefix
+	case *storage.MonthlyNetworkStats, storage.MonthlyNetworkStats:
+		ret = monthlyNetworkStatsPrefix
+	case *storage.SenderActivity, storage.SenderActivity:
+		ret = senderActivityPrefix
+	case *storage.ChainStatsSample, storage.ChainStatsSample:
+		ret = chainStatsSamplePrefix
+	case *storage.GasPriceStats, storage.GasPriceStats:
+		ret = gasPriceStatsPrefix
+	case *storage.SupplyStats, storage.SupplyStats:
+		ret = supplyStatsPrefix
+	case *storage.SupplySnapshot, storage.SupplySnapshot:
+		ret = supplySnapshotPrefix
+	case *storage.ValidatorSet, storage.ValidatorSet:
+		ret = validatorSetPrefix
+	case *storage.ValidatorSetCheckpoint, storage.ValidatorSetCheckpoint:
+		ret = validatorSetCheckpointPrefix
+	case *storage.ValidatorSetDiff, storage.ValidatorSetDiff:
+		ret = validatorSetDiffPrefix
+	case *storage.ValidatorStakeChange, storage.ValidatorStakeChange:
+		ret = validatorStakeChangePrefix
+	case *storage.WeekValidatorSet, storage.WeekValidatorSet:
+		ret = weekValidatorSetPrefix
+	case *storage.DagProductionStats, storage.DagProductionStats:
+		ret = dagProductionStatsPrefix
+	case *storage.DagGraph, storage.DagGraph:
+		ret = dagGraphPrefix
+	case *storage.PeriodInclusionStats, storage.PeriodInclusionStats:
+		ret = periodInclusionStatsPrefix
+	case *storage.DailyInclusionStats, storage.DailyInclusionStats:
+		ret = dailyInclusionStatsPrefix
+	case *storage.CallTrace, storage.CallTrace:
+		ret = callTracePrefix
+	case *storage.MethodTransaction, storage.MethodTransaction:
+		ret = methodTransactionPrefix
+	case *storage.ContractMethods, storage.ContractMethods:
+		ret = contractMethodsPrefix
 	// hack if we aren't passing original type directly to this function, but passing interface{} from other function
 	case *interface{}:
 		ret = GetPrefix(*o.(*interface{}))
@@ -196,6 +298,17 @@ func (s *Storage) forEach(prefix, start_key []byte, fn func(key, res []byte) (st
 	}
 }
 
+func (s *Storage) forEachFromLast(prefix []byte, fn func(key, res []byte) (stop bool)) {
+	iter := s.find(prefix)
+	defer iter.Close()
+
+	for iter.Last(); iter.Valid(); iter.Prev() {
+		if fn(iter.Key(), iter.Value()) {
+			break
+		}
+	}
+}
+
 func (s *Storage) ForEachFromKey(prefix, start_key []byte, fn func(key, res []byte) (stop bool)) {
 	start_key = bytes.Join([][]byte{prefix, start_key}, []byte(""))
 	s.forEach(prefix, start_key, fn, func(iter *pebble.Iterator) { iter.Next() })
@@ -219,7 +332,12 @@ func (s *Storage) ForEach(o interface{}, address string, start *uint64, fn func(
 	s.forEachPrefix(o, address, start, fn, func(iter *pebble.Iterator) { iter.Next() })
 }
 
+// ForEachBackwards iterates over items with the prefix in reverse order. Iteration starts from the last item if start isn't specified
 func (s *Storage) ForEachBackwards(o interface{}, address string, start *uint64, fn func(key, res []byte) (stop bool)) {
+	if start == nil {
+		s.forEachFromLast(GetPrefixKey(GetPrefix(&o), address), fn)
+		return
+	}
 	s.forEachPrefix(o, address, start, fn, func(iter *pebble.Iterator) { iter.Prev() })
 }
 
@@ -246,6 +364,52 @@ func (s *Storage) GetTotalSupply() *storage.TotalSupply {
 	return ptr
 }
 
+func (s *Storage) GetSupplyStats() storage.SupplyStats {
+	res := storage.MakeSupplyStats()
+	err := s.GetFromDB(&res, []byte(GetPrefix(&res)))
+	if err != nil && err != pebble.ErrNotFound {
+		log.WithError(err).Fatal("GetSupplyStats failed")
+	}
+	return res
+}
+
+func (s *Storage) GetLastValidatorSet() (res storage.ValidatorSet) {
+	err := s.GetFromDB(&res, GetPrefixKey(GetPrefix(&res), ""))
+	if err != nil && err != pebble.ErrNotFound {
+		log.WithError(err).Fatal("GetLastValidatorSet failed")
+	}
+	return
+}
+
+// GetValidatorSetCheckpoint returns the last checkpoint saved at or before the block. Checkpoints are saved every ValidatorSetCheckpointInterval blocks
+// and when the set is seeded for the database indexed before the sets were saved
+func (s *Storage) GetValidatorSetCheckpoint(block uint64) *storage.ValidatorSet {
+	res := new(storage.ValidatorSetCheckpoint)
+	prefix := GetPrefixKey(GetPrefix(res), "")
+	iter := s.find(prefix)
+	defer iter.Close()
+	if !iter.SeekLT(getKey(GetPrefix(res), "", block+1)) {
+		return nil
+	}
+	err := rlp.DecodeBytes(iter.Value(), res)
+	if err != nil {
+		log.WithError(err).Fatal("GetValidatorSetCheckpoint failed")
+	}
+	return (*storage.ValidatorSet)(res)
+}
+
+func (s *Storage) GetWeekValidatorSet(year, week int32) *storage.ValidatorSet {
+	res := new(storage.WeekValidatorSet)
+	err := s.GetFromDB(res, GetPrefixKey(GetPrefix(res), storage.FormatIntToKey(storage.FormatWeek(year, week))))
+	if err == pebble.ErrNotFound {
+		return nil
+	}
+	if err != nil {
+		log.WithError(err).Fatal("GetWeekValidatorSet failed")
+	}
+	return (*storage.ValidatorSet)(res)
+}
+
 func (s *Storage) GetAccounts() storage.Accounts {
 	ptr := new(storage.Accounts)
 	err := s.GetFromDB(ptr, GetPrefixKey(GetPrefix(ptr), ""))
@@ -311,12 +475,15 @@ func (s *Storage) GetTransactionLogs(hash string) models.TransactionLogsResponse
 	ptr := new(models.TransactionLogsResponse)
 	err := s.GetFromDB(ptr, GetPrefixKey(GetPrefix(ptr), hash))
 	for i, eventLog := range ptr.Data {
-		name, params, err := events.DecodeEventDynamic(eventLog)
+		name, params, guess, err := events.DecodeEvent(eventLog)
 		if err != nil {
 			log.WithError(err).WithField("name", name).WithField("params", params).Error(err)
 		}
 		eventLog.Name = name
 		eventLog.Params = params
+		if guess {
+			eventLog.Guess = &guess
+		}
 		ptr.Data[i] = eventLog
 	}
 	if err != nil && err != pebble.ErrNotFound {
@@ -346,6 +513,130 @@ func (s *Storage) GetTransactionByHash(hash string) (res storage.Transaction) {
 	return
 }
 
+func (s *Storage) GetDelegation(delegator, validator string) storage.Delegation {
+	res := storage.Delegation{Delegator: strings.ToLower(delegator), Validator: strings.ToLower(validator), Amount: big.NewInt(0)}
+	err := s.GetFromDB(&res, GetPrefixKey(GetPrefix(&res), res.Delegator+res.Validator))
+	if err != nil && err != pebble.ErrNotFound {
+		log.WithError(err).Fatal("GetDelegation failed")
+	}
+	return res
+}
+
+func (s *Storage) GetValidatorProfile(validator string) *storage.ValidatorProfile {
+	res := new(storage.ValidatorProfile)
+	err := s.GetFromDB(res, GetPrefixKey(GetPrefix(res), validator))
+	if err == pebble.ErrNotFound {
+		return nil
+	}
+	if err != nil {
+		log.WithError(err).Fatal("GetValidatorProfile failed")
+	}
+	return res
+}
+
+// GetNetworkStats returns network stats for the window with the specified start. o selects daily or monthly stats
+func (s *Storage) GetNetworkStats(o interface{}, start uint64) storage.NetworkStats {
+	res := storage.MakeNetworkStats(start)
+	err := s.GetFromDB(&res, getKey(GetPrefix(o), "", start))
+	if err != nil && err != pebble.ErrNotFound {
+		log.WithError(err).Fatal("GetNetworkStats failed")
+	}
+	return res
+}
+
+func (s *Storage) GetContractMethods(address string) *storage.ContractMethods {
+	res := &storage.ContractMethods{Address: strings.ToLower(address), Methods: make([]storage.MethodCount, 0)}
+	err := s.GetFromDB(res, GetPrefixKey(GetPrefix(res), address))
+	if err != nil && err != pebble.ErrNotFound {
+		log.WithError(err).Fatal("GetContractMethods failed")
+	}
+	return res
+}
+
+func (s *Storage) GetCallTrace(hash string) *storage.CallTrace {
+	res := new(storage.CallTrace)
+	err := s.GetFromDB(res, GetPrefixKey(GetPrefix(res), hash))
+	if err == pebble.ErrNotFound {
+		return nil
+	}
+	if err != nil {
+		log.WithError(err).Fatal("GetCallTrace failed")
+	}
+	return res
+}
+
+func (s *Storage) GetInclusionStats(o interface{}, start uint64) storage.InclusionStats {
+	res := storage.InclusionStats{Start: start}
+	err := s.GetFromDB(&res, getKey(GetPrefix(o), "", start))
+	if err != nil && err != pebble.ErrNotFound {
+		log.WithError(err).Fatal("GetInclusionStats failed")
+	}
+	return res
+}
+
+func (s *Storage) GetSenderActivity(address string) (res storage.SenderActivity) {
+	err := s.GetFromDB(&res, GetPrefixKey(GetPrefix(&res), address))
+	if err != nil && err != pebble.ErrNotFound {
+		log.WithError(err).Fatal("GetSenderActivity failed")
+	}
+	return
+}
+
+func (s *Storage) GetChainStatsSample(block uint64) *storage.ChainStatsSample {
+	res := new(storage.ChainStatsSample)
+	err := s.GetFromDB(res, GetPrefixKey(GetPrefix(res), storage.FormatIntToKey(block)))
+	if err == pebble.ErrNotFound {
+		return nil
+	}
+	if err != nil {
+		log.WithError(err).Fatal("GetChainStatsSample failed")
+	}
+	return res
+}
+
+func (s *Storage) GetPeriodRewards(period uint64) *storage.PeriodRewards {
+	res := new(storage.PeriodRewards)
+	err := s.GetFromDB(res, GetPrefixKey(GetPrefix(res), storage.FormatIntToKey(period)))
+	if err == pebble.ErrNotFound {
+		return nil
+	}
+	if err != nil {
+		log.WithError(err).Fatal("GetPeriodRewards failed")
+	}
+	return res
+}
+
+func (s *Storage) GetDagGraph(period uint64) *storage.DagGraph {
+	res := new(storage.DagGraph)
+	err := s.GetFromDB(res, GetPrefixKey(GetPrefix(res), storage.FormatIntToKey(period)))
+	if err == pebble.ErrNotFound {
+		return nil
+	}
+	if err != nil {
+		log.WithError(err).Fatal("GetDagGraph failed")
+	}
+	return res
+}
+
+// GetVoteStats returns vote stats of the validator for the window with the specified start. o selects daily or weekly stats
+func (s *Storage) GetVoteStats(o interface{}, validator string, start uint64) storage.VoteStats {
+	res := storage.VoteStats{Start: start}
+	err := s.GetFromDB(&res, getKey(GetPrefix(o), validator, start))
+	if err != nil && err != pebble.ErrNotFound {
+		log.WithError(err).Fatal("GetVoteStats failed")
+	}
+	return res
+}
+
+func (s *Storage) GetDagProductionStats(validator string, start uint64) storage.DagProductionStats {
+	res := storage.DagProductionStats{Start: start}
+	err := s.GetFromDB(&res, getKey(GetPrefix(&res), validator, start))
+	if err != nil && err != pebble.ErrNotFound {
+		log.WithError(err).Fatal("GetDagProductionStats failed")
+	}
+	return res
+}
+
 func (s *Storage) GetFromDB(o interface{}, key []byte) error {
 	value, closer, err := s.get(key)
 	if err != nil {
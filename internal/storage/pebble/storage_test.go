@@ -4,11 +4,18 @@ import (
 	"math/big"
 	"os"
 	"strconv"
+	"strings"
 	"testing"
 
+	"github.com/dailycrypto-me/daily-indexer/internal/common"
+	"github.com/dailycrypto-me/daily-indexer/internal/contracts"
+	"github.com/dailycrypto-me/daily-indexer/internal/events"
 	"github.com/dailycrypto-me/daily-indexer/internal/storage"
 	"github.com/dailycrypto-me/daily-indexer/internal/transaction"
 	"github.com/dailycrypto-me/daily-indexer/models"
+	ethcommon "github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/crypto"
 	"github.com/nleeper/goment"
 	"github.com/stretchr/testify/assert"
 )
@@ -216,4 +223,992 @@ func TestTxByHash(t *testing.T) {
 	assert.Equal(t, tx.Hash, ret.Hash)
 	assert.Equal(t, tx.Input, ret.Input)
 	assert.Equal(t, []any{"0xed4d5f4f3641cbc056e466d15dbe2403e38056f8"}, ret.Calldata.Params)
+
+	// calldata decoded on indexing is saved with the transaction
+	batch = st.NewBatch()
+	batch.AddSingleKey(ret, tx.Hash)
+	batch.CommitBatch()
+	stored := st.GetTransactionByHash(tx.Hash)
+	assert.Equal(t, ret.Calldata, stored.Calldata)
+	assert.Nil(t, stored.Calldata.Guess)
+}
+
+func TestContractABIs(t *testing.T) {
+	st := NewStorage("")
+	defer st.Close()
+
+	address := "0xCa67d0c50C4f5363aA47a11C54486aD8F28A7F2b"
+	abi := `[{"inputs":[{"name":"to","type":"address"}],"name":"ping","outputs":[],"stateMutability":"nonpayable","type":"function"}]`
+
+	assert.Error(t, storage.SaveContractABI(st, contracts.MakeRegistry(), address, "[{"))
+	assert.NoError(t, storage.SaveContractABI(st, contracts.MakeRegistry(), address, abi))
+
+	r := contracts.MakeRegistry()
+	storage.LoadContractABIs(st, r)
+	assert.NotNil(t, r.Get(address))
+	assert.Equal(t, abi, r.GetRaw(address))
+}
+
+func TestSignatures(t *testing.T) {
+	st := NewStorage("")
+	defer st.Close()
+
+	_, err := storage.SaveSignatures(st, contracts.MakeSignatureDB(), []string{"ping(address)", "ping("})
+	assert.Error(t, err)
+	saved, err := storage.SaveSignatures(st, contracts.MakeSignatureDB(), []string{"ping( address )", "Ping(address)"})
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"ping(address)", "Ping(address)"}, saved)
+
+	db := contracts.MakeSignatureDB()
+	storage.LoadSignatures(st, db)
+	assert.Equal(t, []string{"ping(address)"}, db.GetMethods(crypto.Keccak256([]byte("ping(address)"))))
+	assert.Equal(t, []string{"Ping(address)"}, db.GetEvents(crypto.Keccak256Hash([]byte("Ping(address)"))))
+}
+
+func TestLogsIndexes(t *testing.T) {
+	st := NewStorage("")
+	defer st.Close()
+	b := st.NewBatch()
+
+	token := "0xca67d0c50c4f5363aa47a11c54486ad8f28a7f2b"
+	transferTopic := "0xddf252ad1be2c89b69c2b068fc378daa952ba7f163c4a11628f55a4df523b3ef"
+	approvalTopic := "0x8c5be1e5ebec7d5bd14f71427d1e84f3dd0314c0f7b2291e5b200ac8c7c3b925"
+	sender := "0x0000000000000000000000000dc0d841f962759da25547c686fa440cf6c28c61"
+	makeLog := func(address, topic0 string, logIndex uint64) models.EventLog {
+		return models.EventLog{Address: address, Topics: []string{topic0, sender}, LogIndex: logIndex, Data: "0x"}
+	}
+
+	storage.AddLogsToIndexes(b, 1, 0, []models.EventLog{makeLog(token, transferTopic, 0), makeLog(token, approvalTopic, 1)})
+	storage.AddLogsToIndexes(b, 2, 0, []models.EventLog{makeLog(token, transferTopic, 0), makeLog("0x00000000000000000000000000000000000000fe", transferTopic, 1)})
+	b.CommitBatch()
+
+	filter := storage.LogsFilter{Address: &token, ToBlock: 2}
+	page := storage.GetLogsPage(st, filter, 0, 10)
+	assert.Len(t, page.Data, 3)
+	assert.False(t, page.HasNext)
+	assert.Equal(t, uint64(1), page.Data[0].BlockNumber)
+	assert.Equal(t, uint64(2), page.Data[2].BlockNumber)
+
+	// pagination
+	page = storage.GetLogsPage(st, filter, 1, 1)
+	assert.Len(t, page.Data, 1)
+	assert.True(t, page.HasNext)
+	assert.Equal(t, approvalTopic, page.Data[0].Topics[0])
+	assert.Equal(t, uint64(2), page.End)
+
+	// address and topic filter
+	filter.Topic0 = &transferTopic
+	filter.FromBlock = 2
+	page = storage.GetLogsPage(st, filter, 0, 10)
+	assert.Len(t, page.Data, 1)
+	assert.Equal(t, token, page.Data[0].Address)
+
+	// search by topic only
+	page = storage.GetLogsPage(st, storage.LogsFilter{Topic0: &transferTopic, ToBlock: 2}, 0, 10)
+	assert.Len(t, page.Data, 3)
+	page = storage.GetLogsPage(st, storage.LogsFilter{Topic0: &transferTopic, ToBlock: 1}, 0, 10)
+	assert.Len(t, page.Data, 1)
+
+	otherTopic := "0x000000000000000000000000e50b5452b2e8435404dbe06e6a05410c47b7583d"
+	page = storage.GetLogsPage(st, storage.LogsFilter{Topic0: &transferTopic, Topic1: &otherTopic, ToBlock: 2}, 0, 10)
+	assert.Len(t, page.Data, 0)
+}
+
+func makeDposLog(event string, amount int64, addresses ...string) models.EventLog {
+	topics := []string{crypto.Keccak256Hash([]byte(event)).Hex()}
+	for _, address := range addresses {
+		topics = append(topics, ethcommon.HexToHash(address).Hex())
+	}
+	return models.EventLog{
+		Address: "0x00000000000000000000000000000000000000fe",
+		Topics:  topics,
+		Data:    ethcommon.BigToHash(big.NewInt(amount)).Hex(),
+	}
+}
+
+func TestDelegations(t *testing.T) {
+	st := NewStorage("")
+	defer st.Close()
+
+	delegator := "0x0dc0d841f962759da25547c686fa440cf6c28c61"
+	validator1 := "0xed4d5f4f3641cbc056e466d15dbe2403e38056f8"
+	validator2 := "0xfc43217e71ec0a1cc480f3d210cd07cbde7374ec"
+
+	d := storage.MakeDelegationsMap()
+	logs := []models.EventLog{
+		makeDposLog(events.DelegatedEvent, 100, delegator, validator1),
+		makeDposLog(events.DelegatedEvent, 50, delegator, validator2),
+	}
+	logs[1].LogIndex = 1
+	assert.NoError(t, d.UpdateEvents(st, 1, 10, logs))
+	b := st.NewBatch()
+	d.AddToBatch(b)
+	b.CommitBatch()
+
+	d = storage.MakeDelegationsMap()
+	logs = []models.EventLog{
+		makeDposLog(events.UndelegatedEvent, 30, delegator, validator1),
+		makeDposLog(events.RedelegatedEvent, 50, delegator, validator2, validator1),
+	}
+	logs[1].LogIndex = 1
+	assert.NoError(t, d.UpdateEvents(st, 2, 20, logs))
+	b = st.NewBatch()
+	d.AddToBatch(b)
+	b.CommitBatch()
+
+	delegations, total := storage.GetDelegations(st, delegator)
+	assert.Len(t, delegations, 1)
+	assert.Equal(t, validator1, delegations[0].Validator)
+	assert.Equal(t, big.NewInt(120), delegations[0].Amount)
+	assert.Equal(t, uint64(2), delegations[0].LastChangeBlock)
+	assert.Equal(t, big.NewInt(120), total)
+
+	delegators, total := storage.GetDelegators(st, validator1)
+	assert.Len(t, delegators, 1)
+	assert.Equal(t, delegator, delegators[0].Delegator)
+	assert.Equal(t, big.NewInt(120), total)
+
+	// delegation is removed when the whole amount is redelegated
+	delegators, _ = storage.GetDelegators(st, validator2)
+	assert.Len(t, delegators, 0)
+
+	history := storage.GetDelegationEventsPage(st, delegator, 0, 3)
+	assert.Len(t, history.Data, 3)
+	assert.True(t, history.HasNext)
+	assert.Equal(t, events.RedelegatedEvent, history.Data[0].Type)
+	assert.Equal(t, validator1, history.Data[0].ToValidator)
+	assert.Equal(t, events.UndelegatedEvent, history.Data[1].Type)
+	assert.Equal(t, "30", history.Data[1].Amount)
+
+	history = storage.GetDelegationEventsPage(st, delegator, 3, 3)
+	assert.Len(t, history.Data, 1)
+	assert.False(t, history.HasNext)
+	assert.Equal(t, uint64(1), history.Data[0].BlockNumber)
+}
+
+func TestUndelegations(t *testing.T) {
+	st := NewStorage("")
+	defer st.Close()
+
+	delegator := "0x0dc0d841f962759da25547c686fa440cf6c28c61"
+	validator := "0xed4d5f4f3641cbc056e466d15dbe2403e38056f8"
+	apply := func(block uint64, logs ...models.EventLog) {
+		for i := range logs {
+			logs[i].LogIndex = uint64(i)
+		}
+		d := storage.MakeDelegationsMap()
+		assert.NoError(t, d.UpdateEvents(st, block, block*10, logs))
+		b := st.NewBatch()
+		d.AddToBatch(b)
+		b.CommitBatch()
+	}
+
+	apply(1,
+		makeDposLog(events.DelegatedEvent, 100, delegator, validator),
+		makeDposLog(events.UndelegatedEvent, 10, delegator, validator),
+	)
+	apply(2,
+		makeDposLog(events.UndelegatedV2Event, 20, delegator, validator, "0x1"),
+		makeDposLog(events.UndelegatedV2Event, 30, delegator, validator, "0x2"),
+	)
+
+	undelegations := storage.GetUndelegations(st, delegator)
+	assert.Len(t, undelegations, 3)
+	assert.Equal(t, uint64(0), undelegations[0].Id)
+	assert.Equal(t, uint64(1), undelegations[0].RequestBlock)
+	delegations, _ := storage.GetDelegations(st, delegator)
+	assert.Equal(t, big.NewInt(40), delegations[0].Amount)
+
+	model := undelegations[0].ToModel(5, 5)
+	assert.Equal(t, uint64(6), model.UnlockBlock)
+	assert.False(t, model.Withdrawable)
+	assert.True(t, undelegations[0].ToModel(5, 6).Withdrawable)
+
+	apply(3,
+		makeDposLog(events.UndelegateConfirmedEvent, 10, delegator, validator),
+		makeDposLog(events.UndelegateCanceledV2Event, 20, delegator, validator, "0x1"),
+		makeDposLog(events.UndelegateConfirmedV2Event, 30, delegator, validator, "0x2"),
+		makeDposLog(events.UndelegatedV2Event, 5, delegator, validator, "0x3"),
+	)
+
+	undelegations = storage.GetUndelegations(st, delegator)
+	assert.Len(t, undelegations, 1)
+	assert.Equal(t, uint64(3), undelegations[0].Id)
+	assert.Equal(t, big.NewInt(5), undelegations[0].Amount)
+	delegations, _ = storage.GetDelegations(st, delegator)
+	assert.Equal(t, big.NewInt(55), delegations[0].Amount)
+}
+
+func TestValidatorProfiles(t *testing.T) {
+	st := NewStorage("")
+	defer st.Close()
+
+	owner := "0x0dc0d841f962759da25547c686fa440cf6c28c61"
+	validator := "0xed4d5f4f3641cbc056e466d15dbe2403e38056f8"
+	dposABI := contracts.Abis.Get(common.DposContractAddress)
+	apply := func(block uint64, method string, args []interface{}, logs ...models.EventLog) {
+		input, err := dposABI.Pack(method, args...)
+		assert.NoError(t, err)
+		p := storage.MakeValidatorProfilesMap()
+		assert.NoError(t, p.UpdateEvents(st, block, block*10, owner, hexutil.Encode(input), logs))
+		b := st.NewBatch()
+		p.AddToBatch(b)
+		b.CommitBatch()
+	}
+
+	assert.Nil(t, st.GetValidatorProfile(validator))
+	apply(1, "registerValidator",
+		[]interface{}{ethcommon.HexToAddress(validator), []byte{1}, []byte{2}, uint16(500), "description", "endpoint"},
+		makeDposLog(events.ValidatorRegisteredEvent, 0, validator),
+	)
+	apply(5, "setCommission",
+		[]interface{}{ethcommon.HexToAddress(validator), uint16(700)},
+		makeDposLog(events.CommissionSetEvent, 700, validator),
+	)
+	apply(7, "setValidatorInfo",
+		[]interface{}{ethcommon.HexToAddress(validator), "description", "new endpoint"},
+		makeDposLog(events.ValidatorInfoSetEvent, 0, validator),
+	)
+
+	profile := st.GetValidatorProfile(validator)
+	assert.NotNil(t, profile)
+	assert.Equal(t, owner, profile.Owner)
+	assert.Equal(t, uint64(700), profile.Commission)
+	assert.Equal(t, "description", profile.Description)
+	assert.Equal(t, "new endpoint", profile.Endpoint)
+	assert.Equal(t, uint64(1), profile.RegistrationBlock)
+	assert.Equal(t, uint64(10), profile.RegistrationTimestamp)
+	assert.Equal(t, uint64(5), profile.LastCommissionChangeBlock)
+	assert.Equal(t, uint64(7), profile.LastUpdateBlock)
+
+	page := storage.GetValidatorEventsPage(st, validator, 0, 2)
+	assert.True(t, page.HasNext)
+	assert.Len(t, page.Data, 2)
+	assert.Equal(t, events.ValidatorInfoSetEvent, page.Data[0].Type)
+	assert.Equal(t, []string{"endpoint"}, page.Data[0].Changes)
+	assert.Equal(t, events.CommissionSetEvent, page.Data[1].Type)
+	assert.Equal(t, uint64(700), *page.Data[1].Commission)
+
+	page = storage.GetValidatorEventsPage(st, validator, 2, 2)
+	assert.False(t, page.HasNext)
+	assert.Len(t, page.Data, 1)
+	assert.Equal(t, events.ValidatorRegisteredEvent, page.Data[0].Type)
+	assert.Equal(t, []string{"owner", "commission", "description", "endpoint"}, page.Data[0].Changes)
+}
+
+func TestValidatorHistory(t *testing.T) {
+	st := NewStorage("")
+	defer st.Close()
+
+	validator := "0xed4d5f4f3641cbc056e466d15dbe2403e38056f8"
+	other := "0xfc43217e71ec0a1cc480f3d210cd07cbde7374ec"
+	b := st.NewBatch()
+	for week := int32(50); week <= 53; week++ {
+		var stats storage.WeekStats
+		for i := int32(0); i < week-47; i++ {
+			stats.AddPbftBlock(&models.Pbft{Author: validator})
+		}
+		for i := 0; i < 12; i++ {
+			stats.AddPbftBlock(&models.Pbft{Author: other})
+		}
+		storage.AddWeekToValidatorsIndex(b, &stats, 2020, week, func(address string) string { return "0,1" })
+	}
+	var stats storage.WeekStats
+	stats.AddPbftBlock(&models.Pbft{Author: validator})
+	storage.AddWeekToValidatorsIndex(b, &stats, 2021, 1, nil)
+	b.CommitBatch()
+
+	history := storage.GetValidatorHistory(st, validator, storage.FormatWeek(2020, 51), storage.FormatWeek(2021, 1))
+	assert.Len(t, history, 4)
+	assert.Equal(t, uint32(51), history[0].Week)
+	assert.Equal(t, uint64(4), history[0].PbftCount)
+	assert.Equal(t, uint64(16), history[0].TotalPbftCount)
+	assert.Equal(t, uint64(2), history[0].Rank)
+	assert.Equal(t, 0.25, history[0].Share)
+	assert.Equal(t, "0,1", history[0].Yield)
+	assert.Equal(t, uint32(2021), history[3].Year)
+	assert.Equal(t, "", history[3].Yield)
+
+	history = storage.GetValidatorHistory(st, other, storage.FormatWeek(2020, 50), storage.FormatWeek(2020, 52))
+	assert.Len(t, history, 3)
+	assert.Equal(t, uint64(1), history[0].Rank)
+	assert.Equal(t, 0.8, history[0].Share)
+	assert.Equal(t, uint32(52), history[2].Week)
+}
+
+func TestVoteStats(t *testing.T) {
+	st := NewStorage("")
+	defer st.Close()
+
+	validator1 := "0xed4d5f4f3641cbc056e466d15dbe2403e38056f8"
+	validator2 := "0xfc43217e71ec0a1cc480f3d210cd07cbde7374ec"
+	// Monday, 2024-01-01 00:00:00 UTC
+	monday := uint64(1704067200)
+	assert.Equal(t, monday, storage.GetWeekStart(monday+3*24*60*60+100))
+	assert.Equal(t, monday, storage.GetDayStart(monday+100))
+
+	apply := func(timestamp uint64, votes map[string]uint64) {
+		b := st.NewBatch()
+		storage.AddPeriodVotes(st, b, timestamp, []string{validator1, validator2}, votes)
+		b.CommitBatch()
+	}
+	apply(monday+10, map[string]uint64{validator1: 5, validator2: 3})
+	apply(monday+20, map[string]uint64{validator1: 5})
+	apply(monday+24*60*60, map[string]uint64{validator2: 3})
+
+	daily := storage.GetVoteStats(st, new(storage.DailyVoteStats), validator1, monday, monday+7*24*60*60)
+	assert.Len(t, daily, 2)
+	assert.Equal(t, monday, daily[0].Start)
+	assert.Equal(t, uint64(2), daily[0].VotedPeriods)
+	assert.Equal(t, uint64(10), daily[0].VoteWeight)
+	assert.Equal(t, float64(1), daily[0].ParticipationRate)
+	assert.Equal(t, uint64(1), daily[1].MissedPeriods)
+	assert.Equal(t, float64(0), daily[1].ParticipationRate)
+
+	weekly := storage.GetVoteStats(st, new(storage.WeeklyVoteStats), validator2, monday, monday)
+	assert.Len(t, weekly, 1)
+	assert.Equal(t, uint64(3), weekly[0].EligiblePeriods)
+	assert.Equal(t, uint64(2), weekly[0].VotedPeriods)
+	assert.Equal(t, uint64(1), weekly[0].MissedPeriods)
+	assert.Equal(t, uint64(6), weekly[0].VoteWeight)
+}
+
+func TestNetworkStats(t *testing.T) {
+	st := NewStorage("")
+	defer st.Close()
+
+	sender1 := "0x0dc0d841f962759da25547c686fa440cf6c28c61"
+	sender2 := "0xed4d5f4f3641cbc056e466d15dbe2403e38056f8"
+	// 2024-01-31 00:00:00 UTC
+	day := uint64(1706659200)
+	assert.Equal(t, uint64(1704067200), storage.GetMonthStart(day+100))
+
+	apply := func(timestamp uint64, dags uint64, fn func(n *storage.BlockNetworkStats)) {
+		n := storage.MakeBlockNetworkStats()
+		fn(n)
+		b := st.NewBatch()
+		n.AddToBatch(st, b, timestamp, dags)
+		b.CommitBatch()
+	}
+	apply(day+10, 2, func(n *storage.BlockNetworkStats) {
+		n.AddTransaction(sender1, big.NewInt(1), big.NewInt(100))
+		n.AddTransaction(sender1, big.NewInt(1), nil)
+		n.AddNewAddress()
+	})
+	apply(day+20, 1, func(n *storage.BlockNetworkStats) {
+		n.AddTransaction(sender1, big.NewInt(1), big.NewInt(50))
+		n.AddTransaction(sender2, big.NewInt(2), big.NewInt(50))
+		n.AddNewAddress()
+	})
+	// next day and month
+	apply(day+24*60*60, 1, func(n *storage.BlockNetworkStats) {
+		n.AddTransaction(sender1, big.NewInt(1), big.NewInt(10))
+	})
+
+	daily := storage.GetNetworkStatsSeries(st, new(storage.DailyNetworkStats), day, day+24*60*60)
+	assert.Len(t, daily, 2)
+	assert.Equal(t, uint64(4), daily[0].TransactionsCount)
+	assert.Equal(t, uint64(3), daily[0].DagsCount)
+	assert.Equal(t, uint64(2), daily[0].PbftCount)
+	assert.Equal(t, "5", daily[0].Fees)
+	assert.Equal(t, "200", daily[0].Value)
+	assert.Equal(t, uint64(2), daily[0].ActiveSenders)
+	assert.Equal(t, uint64(2), daily[0].NewAddresses)
+	assert.Equal(t, uint64(1), daily[1].ActiveSenders)
+
+	monthly := storage.GetNetworkStatsSeries(st, new(storage.MonthlyNetworkStats), 0, day+24*60*60)
+	assert.Len(t, monthly, 2)
+	assert.Equal(t, uint64(1704067200), monthly[0].Start)
+	assert.Equal(t, uint64(2), monthly[0].ActiveSenders)
+	assert.Equal(t, uint64(1), monthly[1].ActiveSenders)
+	assert.Equal(t, "10", monthly[1].Value)
+}
+
+func TestChainStatsHistory(t *testing.T) {
+	st := NewStorage("")
+	defer st.Close()
+
+	assert.Nil(t, storage.GetChainStats(st, storage.ChainStatsWindows["1h"], 10))
+	// blocks every 4 seconds with 2 transactions each, starting from block 10
+	b := st.NewBatch()
+	for block := uint64(10); block <= 1010; block++ {
+		b.AddSingleKey(storage.ChainStatsSample{Number: block, Timestamp: 1000 + block*4, TrxCount: block * 2}, storage.FormatIntToKey(block))
+	}
+	b.CommitBatch()
+
+	stats := storage.GetChainStats(st, storage.ChainStatsWindows["100blocks"], 1010)
+	assert.Equal(t, uint64(910), stats.StartBlock)
+	assert.Equal(t, uint64(1010), stats.EndBlock)
+	assert.Equal(t, float32(4), stats.BlockInterval)
+	assert.Equal(t, float32(0.5), stats.Tps)
+
+	stats = storage.GetChainStats(st, storage.ChainStatsWindows["1h"], 1010)
+	assert.Equal(t, uint64(110), stats.StartBlock)
+	assert.Equal(t, float32(4), stats.BlockInterval)
+
+	// window is cut by the first sample
+	stats = storage.GetChainStats(st, storage.ChainStatsWindows["24h"], 1010)
+	assert.Equal(t, uint64(10), stats.StartBlock)
+	stats = storage.GetChainStats(st, storage.ChainStatsWindows["100blocks"], 50)
+	assert.Equal(t, uint64(10), stats.StartBlock)
+	assert.Nil(t, storage.GetChainStats(st, storage.ChainStatsWindows["100blocks"], 10))
+
+	history := storage.GetChainStatsHistory(st, storage.ChainStatsWindows["100blocks"], 0, 1010, 11)
+	assert.Len(t, history, 11)
+	assert.Equal(t, uint64(11), history[0].EndBlock)
+	assert.Equal(t, uint64(1010), history[10].EndBlock)
+
+	history = storage.GetChainStatsHistory(st, storage.ChainStatsWindows["1h"], 1000, 1005, 100)
+	assert.Len(t, history, 6)
+}
+
+func TestGasStats(t *testing.T) {
+	st := NewStorage("")
+	defer st.Close()
+
+	assert.Nil(t, storage.MakeGasPriceStats(1, 100, []uint64{}, 0))
+	block := storage.MakeGasPriceStats(1, 100, []uint64{10, 1, 9, 2, 8, 3, 7, 4, 6, 5}, 21000)
+	assert.Equal(t, uint64(1), block.Min)
+	assert.Equal(t, uint64(1), block.P10)
+	assert.Equal(t, uint64(3), block.P25)
+	assert.Equal(t, uint64(5), block.Median)
+	assert.Equal(t, uint64(8), block.P75)
+	assert.Equal(t, uint64(9), block.P90)
+	assert.Equal(t, uint64(10), block.Max)
+
+	assert.Nil(t, storage.GetGasStats(st, 100, 10))
+	// transactions only in even blocks, prices are growing with block number
+	b := st.NewBatch()
+	for block := uint64(2); block <= 20; block += 2 {
+		stats := storage.MakeGasPriceStats(block, 1000+block, []uint64{block, block * 2, block * 3}, 100)
+		b.AddSingleKey(stats, storage.FormatIntToKey(block))
+	}
+	b.CommitBatch()
+
+	stats := storage.GetGasStats(st, 5, 20)
+	assert.Equal(t, uint64(16), stats.FromBlock)
+	assert.Equal(t, uint64(3), stats.BlocksCount)
+	assert.Equal(t, uint64(9), stats.TransactionsCount)
+	assert.Equal(t, uint64(300), stats.GasUsed)
+	assert.Equal(t, uint64(1020), stats.EndTimestamp)
+	assert.Equal(t, uint64(16), stats.Min)
+	assert.Equal(t, uint64(60), stats.Max)
+	assert.Equal(t, uint64(36), stats.Median)
+	assert.Equal(t, uint64(18), stats.SafeLow)
+	assert.Equal(t, stats.Median, stats.Standard)
+	assert.Equal(t, uint64(54), stats.Fast)
+
+	assert.Nil(t, storage.GetGasStats(st, 1, 19))
+
+	history := storage.GetGasStatsHistory(st, 1, 1, 20, 20)
+	assert.Len(t, history, 10)
+	assert.Equal(t, uint64(2), history[0].ToBlock)
+	assert.Equal(t, uint64(20), history[9].ToBlock)
+
+	// overlapping windows match the separately calculated stats
+	history = storage.GetGasStatsHistory(st, 6, 3, 20, 7)
+	assert.Len(t, history, 7)
+	for _, point := range history {
+		assert.Equal(t, *storage.GetGasStats(st, 6, point.ToBlock), point)
+	}
+}
+
+func TestSupply(t *testing.T) {
+	st := NewStorage("")
+	defer st.Close()
+
+	treasury, team := "0x1111111111111111111111111111111111111111", "0x2222222222222222222222222222222222222222"
+	accounts := storage.Accounts{
+		{Address: treasury, Balance: big.NewInt(300)},
+		{Address: common.DposContractAddress, Balance: big.NewInt(200)},
+		{Address: "0x3333333333333333333333333333333333333333", Balance: big.NewInt(500)},
+	}
+	supply := storage.MakeSupplyStats()
+	supply.Block = 10
+	supply.GenesisSupply.SetUint64(900)
+	supply.TotalDelegated.SetUint64(150)
+
+	b := st.NewBatch()
+	b.SaveAccounts(accounts)
+	b.SetTotalSupply(big.NewInt(1000))
+	b.SetSupplyStats(&supply)
+	b.CommitBatch()
+
+	ret := storage.GetSupply(st, []string{treasury, team})
+	assert.Equal(t, uint64(10), ret.Block)
+	assert.Equal(t, "1000", ret.TotalSupply)
+	assert.Equal(t, "150", ret.StakedSupply)
+	assert.Equal(t, "200", ret.DposContractBalance)
+	assert.Equal(t, "900", ret.GenesisSupply)
+	assert.Equal(t, "300", ret.LockedSupply)
+	assert.Equal(t, "700", ret.CirculatingSupply)
+	assert.Len(t, ret.LockedAddresses, 2)
+	assert.Equal(t, "0", ret.LockedAddresses[1].Balance)
+
+	ret = storage.GetSupply(st, nil)
+	assert.Equal(t, "1000", ret.CirculatingSupply)
+}
+
+func TestSupplyHistory(t *testing.T) {
+	st := NewStorage("")
+	defer st.Close()
+
+	supply := storage.MakeSupplyStats()
+	supply.GenesisSupply.SetUint64(1000)
+	b := st.NewBatch()
+	b.SetSupplyStats(&supply)
+	b.CommitBatch()
+
+	assert.Equal(t, "1000", storage.GetPreviousSupply(st).String())
+	for block := uint64(100); block <= 500; block += 100 {
+		snapshot := storage.MakeSupplySnapshot(block, block*4, big.NewInt(int64(1000+block)), big.NewInt(int64(block)), storage.GetPreviousSupply(st))
+		b := st.NewBatch()
+		b.AddSingleKey(snapshot, storage.FormatIntToKey(block))
+		b.CommitBatch()
+	}
+	assert.Equal(t, "1500", storage.GetPreviousSupply(st).String())
+
+	snapshots := storage.GetSupplySnapshots(st, 150, 400)
+	assert.Len(t, snapshots, 3)
+	assert.Equal(t, uint64(200), snapshots[0].Block)
+	assert.Equal(t, "100", snapshots[0].Minted.String())
+
+	first := storage.GetSupplySnapshots(st, 0, 100)[0]
+	assert.Equal(t, "100", first.Minted.String())
+	staking := first.ToStakingModel()
+	assert.Equal(t, "100", staking.TotalDelegated)
+	assert.InDelta(t, 100.0/1100.0, staking.StakingRatio, 1e-9)
+}
+
+func TestValidatorSet(t *testing.T) {
+	st := NewStorage("")
+	defer st.Close()
+
+	v1, v2, v3 := "0x1111111111111111111111111111111111111111", "0x2222222222222222222222222222222222222222", "0x3333333333333333333333333333333333333333"
+	sets := map[uint64]map[string]*big.Int{
+		storage.ValidatorSetCheckpointInterval - 1: {v1: big.NewInt(100), v2: big.NewInt(200)},
+		storage.ValidatorSetCheckpointInterval:     {v1: big.NewInt(100), v2: big.NewInt(200)},
+		storage.ValidatorSetCheckpointInterval + 1: {v1: big.NewInt(150), v2: big.NewInt(200), v3: big.NewInt(10)},
+		storage.ValidatorSetCheckpointInterval + 2: {v1: big.NewInt(150), v3: big.NewInt(10)},
+	}
+	for block := storage.ValidatorSetCheckpointInterval - 1; block <= storage.ValidatorSetCheckpointInterval+2; block++ {
+		b := st.NewBatch()
+		storage.UpdateValidatorSet(st, b, uint64(block), uint64(block*4), sets[uint64(block)])
+		b.CommitBatch()
+	}
+
+	set := storage.GetValidatorSet(st, storage.ValidatorSetCheckpointInterval-1)
+	assert.Len(t, set.Validators, 2)
+	assert.Equal(t, "300", set.ToModel().TotalStake)
+	// nothing was indexed before
+	assert.Len(t, storage.GetValidatorSet(st, 10).Validators, 0)
+
+	set = storage.GetValidatorSet(st, storage.ValidatorSetCheckpointInterval+1)
+	assert.Len(t, set.Validators, 3)
+	assert.Equal(t, v1, set.Validators[0].Address)
+	assert.Equal(t, "150", set.Validators[0].Stake.String())
+
+	set = storage.GetValidatorSet(st, storage.ValidatorSetCheckpointInterval+100)
+	assert.Len(t, set.Validators, 2)
+	assert.Equal(t, v3, set.Validators[1].Address)
+
+	history := storage.GetValidatorStakeHistory(st, v2, 0, storage.ValidatorSetCheckpointInterval+2)
+	assert.Len(t, history, 2)
+	assert.Equal(t, "200", history[0].Delta)
+	assert.Equal(t, "0", history[1].Stake)
+	assert.Equal(t, "-200", history[1].Delta)
+
+	history = storage.GetValidatorStakeHistory(st, v1, storage.ValidatorSetCheckpointInterval, storage.ValidatorSetCheckpointInterval+2)
+	assert.Len(t, history, 1)
+	assert.Equal(t, "50", history[0].Delta)
+}
+
+func TestSeedValidatorSet(t *testing.T) {
+	st := NewStorage("")
+	defer st.Close()
+
+	v1, v2 := "0x1111111111111111111111111111111111111111", "0x2222222222222222222222222222222222222222"
+	b := st.NewBatch()
+	storage.SeedValidatorSet(b, 1005, map[string]*big.Int{strings.ToUpper(v1): big.NewInt(100), v2: big.NewInt(0)})
+	b.CommitBatch()
+
+	set := storage.GetValidatorSet(st, 1005)
+	assert.Len(t, set.Validators, 1)
+	assert.Equal(t, v1, set.Validators[0].Address)
+	assert.Len(t, storage.GetValidatorSet(st, 1004).Validators, 0)
+
+	// unchanged stake isn't recorded as a join of the validator
+	b = st.NewBatch()
+	storage.UpdateValidatorSet(st, b, 1006, 4024, map[string]*big.Int{v1: big.NewInt(100)})
+	b.CommitBatch()
+	assert.Len(t, storage.GetValidatorStakeHistory(st, v1, 0, 1006), 0)
+	assert.Len(t, storage.GetValidatorSet(st, 1006).Validators, 1)
+}
+
+func TestDecentralization(t *testing.T) {
+	st := NewStorage("")
+	defer st.Close()
+
+	equal := storage.GetDistributionMetrics([]*big.Int{big.NewInt(1), big.NewInt(1), big.NewInt(1), big.NewInt(1), big.NewInt(0)}, 1)
+	assert.Equal(t, uint64(4), equal.Participants)
+	assert.Equal(t, uint64(2), equal.NakamotoCoefficient)
+	assert.Equal(t, float32(0), equal.Gini)
+	assert.Equal(t, float32(0.25), equal.TopShare)
+
+	unequal := storage.GetDistributionMetrics([]*big.Int{big.NewInt(1), big.NewInt(3)}, 1)
+	assert.Equal(t, "4", unequal.Total)
+	assert.Equal(t, uint64(1), unequal.NakamotoCoefficient)
+	assert.Equal(t, float32(0.25), unequal.Gini)
+	assert.Equal(t, float32(0.75), unequal.TopShare)
+
+	empty := storage.GetDistributionMetrics([]*big.Int{}, 10)
+	assert.Equal(t, uint64(0), empty.NakamotoCoefficient)
+
+	v1, v2 := "0x1111111111111111111111111111111111111111", "0x2222222222222222222222222222222222222222"
+	year, week := int32(2024), int32(10)
+	weekStats := st.GetWeekStats(year, week)
+	weekStats.Key = []byte(getWeekKey(GetPrefix(&weekStats), year, week))
+	weekStats.AddPeriodDags(map[string]uint64{v1: 3, v2: 1})
+	weekStats.AddPeriodDags(map[string]uint64{v1: 1})
+	b := st.NewBatch()
+	b.UpdateWeekStats(weekStats)
+	storage.UpdateValidatorSet(st, b, 10, 40, map[string]*big.Int{v1: big.NewInt(100), v2: big.NewInt(100)})
+	b.CommitBatch()
+
+	weekStats = st.GetWeekStats(year, week)
+	assert.Len(t, weekStats.Metrics, 2)
+
+	// finished week without saved validator set
+	assert.Nil(t, storage.GetDecentralizationStats(st, year, week, false, 10))
+
+	stats := storage.GetDecentralizationStats(st, year, week, true, 1)
+	assert.Equal(t, uint64(10), stats.Block)
+	assert.Equal(t, uint64(1), stats.Stake.NakamotoCoefficient)
+	assert.Equal(t, float32(0.5), stats.Stake.TopShare)
+	assert.Equal(t, "5", stats.Dags.Total)
+	assert.Equal(t, float32(0.8), stats.Dags.TopShare)
+	assert.Equal(t, uint64(0), stats.Pbft.Participants)
+
+	b = st.NewBatch()
+	b.AddSingleKey(storage.WeekValidatorSet(storage.GetValidatorSet(st, 10)), storage.FormatIntToKey(storage.FormatWeek(year, week)))
+	b.CommitBatch()
+	stats = storage.GetDecentralizationStats(st, year, week, false, 1)
+	assert.Equal(t, "200", stats.Stake.Total)
+}
+
+func TestEligibleValidators(t *testing.T) {
+	st := NewStorage("")
+	defer st.Close()
+
+	v1, v2, v3 := "0x1111111111111111111111111111111111111111", "0x2222222222222222222222222222222222222222", "0x3333333333333333333333333333333333333333"
+	config := common.DefaultConfig()
+	config.Chain.EligibilityBalanceThreshold = big.NewInt(100)
+	sets := []map[string]*big.Int{
+		{v1: big.NewInt(100), v2: big.NewInt(50)},
+		{v1: big.NewInt(100), v2: big.NewInt(150), v3: big.NewInt(10)},
+		{v1: big.NewInt(99), v2: big.NewInt(150), v3: big.NewInt(10)},
+		{v1: big.NewInt(120), v2: big.NewInt(150), v3: big.NewInt(20)},
+	}
+	for i, stakes := range sets {
+		b := st.NewBatch()
+		storage.UpdateValidatorSet(st, b, uint64(i+1), uint64(i*4), stakes)
+		b.CommitBatch()
+	}
+
+	set := storage.GetValidatorSet(st, 4)
+	eligible := storage.GetEligibleValidators(st, config, &set)
+	assert.Equal(t, "100", eligible.Threshold)
+	assert.Len(t, eligible.Data, 2)
+	assert.Equal(t, v2, eligible.Data[0].Address)
+	assert.Equal(t, "50", eligible.Data[0].Distance)
+	assert.Equal(t, uint64(2), eligible.Data[0].EligibleSince)
+	assert.Equal(t, uint64(4), eligible.Data[1].EligibleSince)
+
+	set = storage.GetValidatorSet(st, 3)
+	eligible = storage.GetEligibleValidators(st, config, &set)
+	assert.Len(t, eligible.Data, 1)
+	// later changes of the stake are skipped for the historical set
+	assert.Equal(t, uint64(2), eligible.Data[0].EligibleSince)
+
+	changes := storage.GetEligibilityChanges(st, config, v1, 0, 4)
+	assert.Len(t, changes, 3)
+	assert.True(t, changes[0].Eligible)
+	assert.False(t, changes[1].Eligible)
+	assert.Equal(t, uint64(3), changes[1].Block)
+	assert.Equal(t, "99", changes[1].Stake)
+	assert.Len(t, storage.GetEligibilityChanges(st, config, v3, 0, 4), 0)
+}
+
+func TestValidatorsLeaderboard(t *testing.T) {
+	st := NewStorage("")
+	defer st.Close()
+
+	v1, v2, v3 := "0x1111111111111111111111111111111111111111", "0x2222222222222222222222222222222222222222", "0x3333333333333333333333333333333333333333"
+	year, week := int32(2024), int32(10)
+	weekStats := st.GetWeekStats(year, week)
+	weekStats.Key = []byte(getWeekKey(GetPrefix(&weekStats), year, week))
+	for _, author := range []string{v1, v1, v2, v3} {
+		weekStats.AddPbftBlock(&models.Pbft{Author: author})
+	}
+	periodStats := &storage.RewardsStats{ValidatorsStats: []storage.ValidatorStatsWithAddress{
+		{Address: v2, ValidatorStats: storage.ValidatorStats{DagBlocksCount: 2, VoteWeight: 5}},
+	}}
+	rewards := map[string]*storage.ValidatorRewards{v3: {Validator: v3, Dags: big.NewInt(10), Votes: big.NewInt(5), Bonus: big.NewInt(0), Fees: big.NewInt(1)}}
+	stakes := map[string]*big.Int{v1: big.NewInt(100), v2: big.NewInt(300), v3: big.NewInt(200)}
+	weekStats.AddPeriodMetrics(v1, map[string]uint64{v2: 3, v3: 1}, periodStats, rewards, stakes)
+	weekStats.AddPeriodMetrics(v2, map[string]uint64{v2: 1}, periodStats, nil, stakes)
+
+	b := st.NewBatch()
+	b.UpdateWeekStats(weekStats)
+	b.CommitBatch()
+
+	weekStats = st.GetWeekStats(year, week)
+	validators := weekStats.GetValidators()
+	storage.SortValidators(validators, storage.SortByDags, false)
+	assert.Equal(t, v2, validators[0].Address)
+	assert.Equal(t, uint64(4), validators[0].DagsCount)
+	assert.Equal(t, uint64(4), validators[0].RewardableDagsCount)
+	assert.Equal(t, uint64(10), validators[0].VoteWeight)
+	assert.Equal(t, uint64(1), validators[0].Rank)
+
+	storage.SortValidators(validators, storage.SortByStake, true)
+	assert.Equal(t, v1, validators[0].Address)
+	assert.Equal(t, uint64(3), validators[0].Rank)
+	assert.Equal(t, "300", validators[2].Stake)
+
+	storage.SortValidators(validators, storage.SortByRewards, false)
+	assert.Equal(t, v3, validators[0].Address)
+	assert.Equal(t, "16", validators[0].Rewards)
+
+	validators[0].Yield, validators[1].Yield, validators[2].Yield = "0.1000", "0.3000", "0.2000"
+	storage.SortValidators(validators, storage.SortByYield, false)
+	assert.Equal(t, "0.3000", validators[0].Yield)
+
+	page, pagination := storage.PaginateValidators(validators, 1, 1)
+	assert.Len(t, page, 1)
+	assert.Equal(t, uint64(2), page[0].Rank)
+	assert.True(t, pagination.HasNext)
+
+	// stats saved before metrics were added are decoded without them
+	legacy := struct {
+		Validators []models.Validator
+		Total      uint32
+	}{Validators: []models.Validator{{Address: v1, PbftCount: 1}}, Total: 1}
+	b = st.NewBatch()
+	assert.NoError(t, b.AddWithKey(legacy, getWeekKey(GetPrefix(&weekStats), year, week+1)))
+	b.CommitBatch()
+	weekStats = st.GetWeekStats(year, week+1)
+	assert.Len(t, weekStats.Metrics, 0)
+	assert.Equal(t, "0", weekStats.GetValidators()[0].Stake)
+
+	// metrics saved with DAG blocks count only are decoded with zero rewards and stake
+	legacyMetrics := struct {
+		Validators []models.Validator
+		Total      uint32
+		Metrics    []struct {
+			Address   string
+			DagsCount uint64
+		}
+	}{Validators: legacy.Validators, Total: 1}
+	legacyMetrics.Metrics = append(legacyMetrics.Metrics, struct {
+		Address   string
+		DagsCount uint64
+	}{Address: v1, DagsCount: 2})
+	b = st.NewBatch()
+	assert.NoError(t, b.AddWithKey(legacyMetrics, getWeekKey(GetPrefix(&weekStats), year, week+2)))
+	b.CommitBatch()
+	weekStats = st.GetWeekStats(year, week+2)
+	weekStats.AddPeriodMetrics(v1, nil, nil, map[string]*storage.ValidatorRewards{v1: rewards[v3]}, nil)
+	validators = weekStats.GetValidators()
+	assert.Equal(t, uint64(2), validators[0].DagsCount)
+	assert.Equal(t, "16", validators[0].Rewards)
+	assert.Equal(t, "0", validators[0].Stake)
+}
+
+func TestDagProductionStats(t *testing.T) {
+	st := NewStorage("")
+	defer st.Close()
+
+	v1, v2 := "0x1111111111111111111111111111111111111111", "0x2222222222222222222222222222222222222222"
+	// Monday 2024-03-04
+	weekStart := uint64(1709510400)
+	rewardsStats := func(counts map[string]uint64) *storage.RewardsStats {
+		stats := &storage.RewardsStats{}
+		for address, count := range counts {
+			stats.ValidatorsStats = append(stats.ValidatorsStats, storage.ValidatorStatsWithAddress{Address: address, ValidatorStats: storage.ValidatorStats{DagBlocksCount: count}})
+		}
+		return stats
+	}
+	periods := []struct {
+		timestamp uint64
+		dags      []storage.PeriodDag
+		rewards   *storage.RewardsStats
+	}{
+		{weekStart + 10, []storage.PeriodDag{
+			{Sender: v1, Difficulty: 16, Transactions: []string{"0x1", "0x2"}},
+			{Sender: v2, Difficulty: 18, Transactions: []string{"0x2"}},
+			{Sender: v1, Difficulty: 17, Transactions: []string{"0x3", "0x4"}},
+		}, rewardsStats(map[string]uint64{v1: 2})},
+		{weekStart + 20, []storage.PeriodDag{
+			{Sender: v2, Difficulty: 16, Transactions: []string{"0x4"}},
+		}, rewardsStats(map[string]uint64{v2: 1})},
+		{weekStart + 7*24*60*60, []storage.PeriodDag{
+			{Sender: v1, Difficulty: 16, Transactions: []string{}},
+		}, nil},
+	}
+	for _, p := range periods {
+		b := st.NewBatch()
+		storage.AddPeriodDags(st, b, p.timestamp, p.dags, p.rewards)
+		b.CommitBatch()
+	}
+
+	stats := storage.GetDagProductionStats(st, v1, 0, weekStart+7*24*60*60)
+	assert.Len(t, stats, 2)
+	assert.Equal(t, weekStart, stats[0].Start)
+	assert.Equal(t, uint64(2), stats[0].DagsCount)
+	assert.Equal(t, uint64(2), stats[0].RewardableDagsCount)
+	assert.Equal(t, uint64(4), stats[0].TransactionsCount)
+	assert.Equal(t, 16.5, stats[0].AverageDifficulty)
+	assert.Equal(t, uint64(1), stats[1].UnrewardedDagsCount)
+
+	stats = storage.GetDagProductionStats(st, v2, weekStart, weekStart)
+	assert.Len(t, stats, 1)
+	assert.Equal(t, uint64(2), stats[0].DagsCount)
+	assert.Equal(t, uint64(1), stats[0].RewardableDagsCount)
+	assert.Equal(t, uint64(17), uint64(stats[0].AverageDifficulty))
+}
+
+func TestDagGraph(t *testing.T) {
+	st := NewStorage("")
+	defer st.Close()
+
+	nodes := []storage.DagNode{
+		{Hash: "0x01", Pivot: "0x00"},
+		{Hash: "0x02", Pivot: "0x01"},
+		{Hash: "0x03", Pivot: "0x02", Tips: []string{"0x01"}},
+		{Hash: "0x04", Pivot: "0x03"},
+	}
+	b := st.NewBatch()
+	b.AddSingleKey(storage.MakeDagGraph(10, nodes), storage.FormatIntToKey(10))
+	b.CommitBatch()
+
+	assert.Nil(t, st.GetDagGraph(11))
+	graph := st.GetDagGraph(10).ToModel()
+	assert.Equal(t, uint64(10), graph.Period)
+	assert.Len(t, graph.Nodes, 4)
+	// blocks keep the period order
+	for k, hash := range []string{"0x01", "0x02", "0x03", "0x04"} {
+		assert.Equal(t, hash, graph.Nodes[k].Hash)
+		assert.Equal(t, uint64(k), graph.Nodes[k].Order)
+	}
+	assert.Len(t, graph.Edges, 5)
+	assert.Equal(t, models.DagEdge{From: "0x03", To: "0x01", Type: models.Tip}, graph.Edges[3])
+}
+
+func TestInclusionStats(t *testing.T) {
+	st := NewStorage("")
+	defer st.Close()
+
+	dayStart := uint64(1709510400)
+	dags := []storage.PeriodDag{
+		{Timestamp: dayStart + 8, Transactions: []string{"0x2", "0x3"}},
+		{Timestamp: dayStart + 5, Transactions: []string{"0x1", "0x2"}},
+		{Timestamp: dayStart + 9, Transactions: []string{"0x2", "0x5"}},
+	}
+	inclusions := storage.GetTransactionsInclusion(dayStart+10, dags, []string{"0x1", "0x2", "0x3", "0x4"})
+	// transaction that isn't included in the period DAG blocks is skipped as well as DAG transaction that isn't finalized in the period
+	assert.Len(t, inclusions, 3)
+	assert.Equal(t, storage.TransactionInclusion{Latency: 5, DagsCount: 3}, inclusions["0x2"])
+	assert.Equal(t, storage.TransactionInclusion{Latency: 2, DagsCount: 1}, inclusions["0x3"])
+
+	b := st.NewBatch()
+	storage.AddPeriodInclusions(st, b, 10, dayStart+10, inclusions)
+	b.CommitBatch()
+	b = st.NewBatch()
+	storage.AddPeriodInclusions(st, b, 11, dayStart+20, map[string]storage.TransactionInclusion{"0x6": {Latency: 1, DagsCount: 1}})
+	b.CommitBatch()
+
+	periods := storage.GetInclusionStatsSeries(st, new(storage.PeriodInclusionStats), 0, 10)
+	assert.Len(t, periods, 1)
+	assert.Equal(t, uint64(3), periods[0].TransactionsCount)
+	assert.Equal(t, uint64(2), periods[0].MinLatency)
+	assert.Equal(t, uint64(5), periods[0].MaxLatency)
+	assert.Equal(t, 4.0, periods[0].AverageLatency)
+	assert.Equal(t, uint64(2), periods[0].Duplicates)
+
+	daily := storage.GetInclusionStatsSeries(st, new(storage.DailyInclusionStats), dayStart, dayStart)
+	assert.Len(t, daily, 1)
+	assert.Equal(t, uint64(4), daily[0].TransactionsCount)
+	assert.Equal(t, uint64(1), daily[0].MinLatency)
+	assert.Equal(t, 3.25, daily[0].AverageLatency)
+}
+
+func TestCallTrace(t *testing.T) {
+	st := NewStorage("")
+	defer st.Close()
+
+	hash := "0x0000000000000000000000000000000000000000000000000000000000000001"
+	trace := storage.CallTrace{Calls: []storage.TraceCall{
+		{TraceAddress: []uint16{}, Type: "call", CallType: "call", From: "0x1", To: "0x2", Value: big.NewInt(1), Gas: 100, GasUsed: 50, Input: "0x5c19a95c"},
+		{TraceAddress: []uint16{0}, Type: "call", CallType: "delegatecall", From: "0x2", To: "0x3", Value: big.NewInt(0), Gas: 80, GasUsed: 30},
+		{TraceAddress: []uint16{0, 0}, Type: "call", CallType: "staticcall", From: "0x3", To: "0x4", Value: big.NewInt(0), Gas: 40, GasUsed: 10, Output: "0x01"},
+		{TraceAddress: []uint16{1}, Type: "create", From: "0x2", To: "0x5", Value: big.NewInt(2), Gas: 20, GasUsed: 15},
+	}}
+	b := st.NewBatch()
+	b.AddSingleKey(&trace, hash)
+	b.CommitBatch()
+
+	assert.Nil(t, st.GetCallTrace("0x2"))
+	saved := st.GetCallTrace(hash)
+	assert.NotNil(t, saved)
+
+	root := saved.ToModel()
+	assert.Equal(t, "call", root.Type)
+	assert.Equal(t, "1", root.Value)
+	assert.Len(t, root.Calls, 2)
+	assert.Equal(t, "delegatecall", root.Calls[0].Type)
+	assert.Equal(t, "create", root.Calls[1].Type)
+	assert.Equal(t, "0x5", root.Calls[1].To)
+	assert.Len(t, root.Calls[0].Calls, 1)
+	assert.Equal(t, "staticcall", root.Calls[0].Calls[0].Type)
+	assert.Equal(t, uint64(10), root.Calls[0].Calls[0].GasUsed)
+	assert.Equal(t, "0x01", root.Calls[0].Calls[0].Output)
+
+	assert.Nil(t, (&storage.CallTrace{}).ToModel())
+}
+
+func TestMethodIndex(t *testing.T) {
+	st := NewStorage("")
+	defer st.Close()
+
+	contract := "0x00000000000000000000000000000000000000FE"
+	methods := storage.MakeContractMethodsMap()
+	b := st.NewBatch()
+	for i, method := range []string{"delegate(address)", "undelegate(address,uint256)", "delegate(address)", "delegate(address)"} {
+		trx := storage.Transaction{Hash: "0x" + strconv.Itoa(i), To: contract, Method: method, Value: big.NewInt(0), GasCost: big.NewInt(0)}
+		b.Add(storage.MethodTransaction(trx), storage.GetMethodKey(contract, method), methods.AddCall(st, contract, method))
+	}
+	methods.AddToBatch(b)
+	b.CommitBatch()
+
+	counts := st.GetContractMethods(contract).ToModel()
+	assert.Equal(t, "0x00000000000000000000000000000000000000fe", counts.Address)
+	assert.Equal(t, []models.MethodCount{{Method: "delegate(address)", Count: 3}, {Method: "undelegate(address,uint256)", Count: 1}}, counts.Data)
+
+	// the latest calls are returned first
+	page, pagination := storage.GetMethodTransactionsPage(st, contract, "delegate(address)", 0, 2)
+	assert.Len(t, page, 2)
+	assert.Equal(t, "0x3", page[0].Hash)
+	assert.Equal(t, "0x2", page[1].Hash)
+	assert.Equal(t, uint64(3), pagination.Total)
+	assert.True(t, pagination.HasNext)
+
+	page, pagination = storage.GetMethodTransactionsPage(st, contract, "delegate(address)", 2, 2)
+	assert.Len(t, page, 1)
+	assert.Equal(t, "0x0", page[0].Hash)
+	assert.False(t, pagination.HasNext)
+
+	page, _ = storage.GetMethodTransactionsPage(st, contract, "transfer(address,uint256)", 0, 10)
+	assert.Len(t, page, 0)
+	assert.Len(t, st.GetContractMethods("0x1").Methods, 0)
 }
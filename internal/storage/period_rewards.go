@@ -0,0 +1,104 @@
+package storage
+
+import (
+	"math/big"
+	"sort"
+
+	"github.com/dailycrypto-me/daily-indexer/models"
+	"github.com/ethereum/go-ethereum/rlp"
+	log "github.com/sirupsen/logrus"
+)
+
+// ValidatorRewards is the reward of the validator in the distribution period split by categories.
+// It is saved to the index by validator with the period key
+type ValidatorRewards struct {
+	Validator string
+	Period    uint64
+	Dags      *big.Int
+	Votes     *big.Int
+	Bonus     *big.Int
+	Fees      *big.Int
+}
+
+func MakeValidatorRewards(validator string) *ValidatorRewards {
+	return &ValidatorRewards{Validator: validator, Dags: big.NewInt(0), Votes: big.NewInt(0), Bonus: big.NewInt(0), Fees: big.NewInt(0)}
+}
+
+func (v *ValidatorRewards) Add(o *ValidatorRewards) {
+	v.Dags.Add(v.Dags, o.Dags)
+	v.Votes.Add(v.Votes, o.Votes)
+	v.Bonus.Add(v.Bonus, o.Bonus)
+	v.Fees.Add(v.Fees, o.Fees)
+}
+
+// Total returns sum of all categories. Fees aren't minted, but they are added to the validator commission pool
+func (v *ValidatorRewards) Total() *big.Int {
+	total := big.NewInt(0).Add(v.Dags, v.Votes)
+	total.Add(total, v.Bonus)
+	return total.Add(total, v.Fees)
+}
+
+func (v *ValidatorRewards) ToModel() models.ValidatorRewards {
+	return models.ValidatorRewards{
+		Validator: v.Validator,
+		Dags:      v.Dags.String(),
+		Votes:     v.Votes.String(),
+		Bonus:     v.Bonus.String(),
+		Fees:      v.Fees.String(),
+		Total:     v.Total().String(),
+	}
+}
+
+// PeriodRewards is the rewards breakdown of the distribution period
+type PeriodRewards struct {
+	Period      uint64
+	TotalReward *big.Int
+	BlockFee    *big.Int
+	Validators  []ValidatorRewards
+}
+
+func (p *PeriodRewards) ToModel() models.PeriodRewards {
+	ret := models.PeriodRewards{
+		Period:      p.Period,
+		TotalReward: p.TotalReward.String(),
+		BlockFee:    p.BlockFee.String(),
+		Validators:  make([]models.ValidatorRewards, 0, len(p.Validators)),
+	}
+	for _, v := range p.Validators {
+		ret.Validators = append(ret.Validators, v.ToModel())
+	}
+	return ret
+}
+
+// AddPeriodRewards saves rewards breakdown of the distribution period and adds it to the index by validator
+func AddPeriodRewards(b Batch, period uint64, totalReward, blockFee *big.Int, validators map[string]*ValidatorRewards) {
+	p := PeriodRewards{Period: period, TotalReward: totalReward, BlockFee: blockFee, Validators: make([]ValidatorRewards, 0, len(validators))}
+	for _, v := range validators {
+		v.Period = period
+		p.Validators = append(p.Validators, *v)
+		b.Add(v, v.Validator, period)
+	}
+	sort.Slice(p.Validators, func(i, j int) bool {
+		return p.Validators[i].Validator < p.Validators[j].Validator
+	})
+	b.AddSingleKey(&p, FormatIntToKey(period))
+}
+
+// GetValidatorRewards returns rewards of the validator distributed in the [from, to] blocks range summed by categories along with the number of periods
+func GetValidatorRewards(s Storage, validator string, from, to uint64) (total *ValidatorRewards, count uint64) {
+	total = MakeValidatorRewards(validator)
+	s.ForEach(new(ValidatorRewards), validator, &from, func(_, res []byte) (stop bool) {
+		var v ValidatorRewards
+		err := rlp.DecodeBytes(res, &v)
+		if err != nil {
+			log.WithError(err).Fatal("Error decoding validator rewards from db")
+		}
+		if v.Period > to {
+			return true
+		}
+		total.Add(&v)
+		count++
+		return false
+	})
+	return
+}
@@ -0,0 +1,51 @@
+package storage
+
+import (
+	"github.com/dailycrypto-me/daily-indexer/internal/contracts"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/rlp"
+	log "github.com/sirupsen/logrus"
+)
+
+// TextSignature is a function or event signature added in runtime. Built-in signatures aren't stored
+type TextSignature struct {
+	Signature string `json:"signature"`
+}
+
+// LoadSignatures adds all signatures saved in the storage to the signature database
+func LoadSignatures(s Storage, db *contracts.SignatureDB) {
+	count := 0
+	s.ForEach(new(TextSignature), "", nil, func(_, res []byte) (stop bool) {
+		var o TextSignature
+		err := rlp.DecodeBytes(res, &o)
+		if err != nil {
+			log.WithError(err).Fatal("Error decoding text signature from db")
+		}
+		if _, err := db.Add(o.Signature); err != nil {
+			log.WithError(err).WithField("signature", o.Signature).Error("Failed to parse stored signature")
+			return
+		}
+		count++
+		return
+	})
+	log.WithField("count", count).Info("Loaded text signatures")
+}
+
+// SaveSignatures adds signatures to the database and saves them to the storage. Nothing is saved if any of signatures is invalid
+func SaveSignatures(s Storage, db *contracts.SignatureDB, signatures []string) ([]string, error) {
+	normalized := make([]string, 0, len(signatures))
+	for _, signature := range signatures {
+		n, err := contracts.NormalizeSignature(signature)
+		if err != nil {
+			return nil, err
+		}
+		normalized = append(normalized, n)
+	}
+	b := s.NewBatch()
+	for _, signature := range normalized {
+		db.Add(signature)
+		b.AddSingleKey(TextSignature{Signature: signature}, crypto.Keccak256Hash([]byte(signature)).Hex())
+	}
+	b.CommitBatch()
+	return normalized, nil
+}
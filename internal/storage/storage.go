@@ -17,6 +17,7 @@ type Storage interface {
 	ForEachFromKeyBackwards(prefix, start_key []byte, fn func(key, res []byte) (stop bool))
 	NewBatch() Batch
 	GetTotalSupply() *TotalSupply
+	GetSupplyStats() SupplyStats
 	GetAccounts() Accounts
 	GetWeekStats(year, week int32) WeekStats
 	GetFinalizationData() *FinalizationData
@@ -28,6 +29,21 @@ type Storage interface {
 	GetTransactionLogs(hash string) models.TransactionLogsResponse
 	GetValidatorYield(validator string, block uint64) (res Yield)
 	GetTotalYield(block uint64) (res Yield)
+	GetDelegation(delegator, validator string) Delegation
+	GetValidatorProfile(validator string) *ValidatorProfile
+	GetVoteStats(o interface{}, validator string, start uint64) VoteStats
+	GetPeriodRewards(period uint64) *PeriodRewards
+	GetDagGraph(period uint64) *DagGraph
+	GetInclusionStats(o interface{}, start uint64) InclusionStats
+	GetCallTrace(hash string) *CallTrace
+	GetContractMethods(address string) *ContractMethods
+	GetNetworkStats(o interface{}, start uint64) NetworkStats
+	GetSenderActivity(address string) SenderActivity
+	GetChainStatsSample(block uint64) *ChainStatsSample
+	GetLastValidatorSet() ValidatorSet
+	GetValidatorSetCheckpoint(block uint64) *ValidatorSet
+	GetWeekValidatorSet(year, week int32) *ValidatorSet
+	GetDagProductionStats(validator string, start uint64) DagProductionStats
 }
 
 func GetTotal[T Paginated](s Storage, address string) (r uint64) {
@@ -0,0 +1,135 @@
+package storage
+
+import (
+	"math/big"
+
+	"github.com/dailycrypto-me/daily-indexer/internal/common"
+	"github.com/dailycrypto-me/daily-indexer/models"
+	"github.com/ethereum/go-ethereum/rlp"
+	log "github.com/sirupsen/logrus"
+)
+
+// SupplyStats is the part of the supply state that isn't tracked by TotalSupply and Accounts. It is updated with every block
+type SupplyStats struct {
+	Block          uint64
+	GenesisSupply  *big.Int
+	TotalDelegated *big.Int
+}
+
+func MakeSupplyStats() SupplyStats {
+	return SupplyStats{GenesisSupply: big.NewInt(0), TotalDelegated: big.NewInt(0)}
+}
+
+// GetSupply returns the supply breakdown. Balances of the locked addresses are subtracted from the circulating supply
+func GetSupply(s Storage, lockedAddresses []string) models.Supply {
+	stats := s.GetSupplyStats()
+	total := s.GetTotalSupply()
+	accounts := s.GetAccounts()
+
+	dposBalance := big.NewInt(0)
+	if dpos := accounts.FindBalance(common.DposContractAddress); dpos != nil {
+		dposBalance = dpos.Balance
+	}
+
+	locked := big.NewInt(0)
+	lockedAccounts := make([]models.Account, 0, len(lockedAddresses))
+	for _, address := range lockedAddresses {
+		account := Account{Address: address, Balance: big.NewInt(0)}
+		if found := accounts.FindBalance(address); found != nil {
+			account.Balance = found.Balance
+		}
+		locked.Add(locked, account.Balance)
+		lockedAccounts = append(lockedAccounts, account.ToModel())
+	}
+	circulating := big.NewInt(0).Sub(total, locked)
+	if circulating.Sign() < 0 {
+		circulating.SetUint64(0)
+	}
+
+	return models.Supply{
+		Block:               stats.Block,
+		TotalSupply:         total.String(),
+		StakedSupply:        stats.TotalDelegated.String(),
+		DposContractBalance: dposBalance.String(),
+		GenesisSupply:       stats.GenesisSupply.String(),
+		LockedSupply:        locked.String(),
+		CirculatingSupply:   circulating.String(),
+		LockedAddresses:     lockedAccounts,
+	}
+}
+
+// SupplySnapshot is the supply state saved at the end of every supply saving interval
+type SupplySnapshot struct {
+	Block          uint64
+	Timestamp      uint64
+	TotalSupply    *big.Int
+	TotalDelegated *big.Int
+	// Minted is the supply increase since the previous snapshot
+	Minted *big.Int
+}
+
+// MakeSupplySnapshot creates snapshot of the supply state. previousSupply is the supply of the previous snapshot or genesis supply for the first one
+func MakeSupplySnapshot(block, timestamp uint64, totalSupply, totalDelegated, previousSupply *big.Int) *SupplySnapshot {
+	return &SupplySnapshot{
+		Block:          block,
+		Timestamp:      timestamp,
+		TotalSupply:    big.NewInt(0).Set(totalSupply),
+		TotalDelegated: big.NewInt(0).Set(totalDelegated),
+		Minted:         big.NewInt(0).Sub(totalSupply, previousSupply),
+	}
+}
+
+// GetPreviousSupply returns total supply of the last saved snapshot or genesis supply if there are no snapshots yet
+func GetPreviousSupply(s Storage) *big.Int {
+	previous := s.GetSupplyStats().GenesisSupply
+	s.ForEachBackwards(new(SupplySnapshot), "", nil, func(_, res []byte) (stop bool) {
+		var last SupplySnapshot
+		err := rlp.DecodeBytes(res, &last)
+		if err != nil {
+			log.WithError(err).Fatal("Error decoding supply snapshot from db")
+		}
+		previous = last.TotalSupply
+		return true
+	})
+	return previous
+}
+
+// GetSupplySnapshots returns snapshots saved in the [from, to] blocks range
+func GetSupplySnapshots(s Storage, from, to uint64) []SupplySnapshot {
+	ret := make([]SupplySnapshot, 0)
+	s.ForEach(new(SupplySnapshot), "", &from, func(_, res []byte) (stop bool) {
+		var snapshot SupplySnapshot
+		err := rlp.DecodeBytes(res, &snapshot)
+		if err != nil {
+			log.WithError(err).Fatal("Error decoding supply snapshot from db")
+		}
+		if snapshot.Block > to {
+			return true
+		}
+		ret = append(ret, snapshot)
+		return false
+	})
+	return ret
+}
+
+func (s *SupplySnapshot) ToSupplyModel() models.SupplySnapshot {
+	return models.SupplySnapshot{
+		Block:       s.Block,
+		Timestamp:   s.Timestamp,
+		TotalSupply: s.TotalSupply.String(),
+		Minted:      s.Minted.String(),
+	}
+}
+
+func (s *SupplySnapshot) ToStakingModel() models.StakingSnapshot {
+	ret := models.StakingSnapshot{
+		Block:          s.Block,
+		Timestamp:      s.Timestamp,
+		TotalSupply:    s.TotalSupply.String(),
+		TotalDelegated: s.TotalDelegated.String(),
+	}
+	if s.TotalSupply.Sign() > 0 {
+		ret.StakingRatio, _ = new(big.Float).Quo(new(big.Float).SetInt(s.TotalDelegated), new(big.Float).SetInt(s.TotalSupply)).Float64()
+	}
+	return ret
+}
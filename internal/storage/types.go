@@ -183,9 +183,12 @@ func FormatIntToKey(i uint64) string {
 	return fmt.Sprintf("%020d", i)
 }
 
+// TransactionVersion is the current version of the transaction record format. Records of version 0 were saved without receipt fields
+const TransactionVersion = 1
+
 type Transaction struct {
 	BlockNumber models.Uint64          `json:"blockNumber"`
-	Calldata    *models.CallData       `json:"calldata,omitempty" rlp:"nil"`
+	Calldata    *Calldata              `json:"calldata,omitempty" rlp:"nil"`
 	From        models.Address         `json:"from"`
 	GasCost     *big.Int               `json:"gas_cost"`
 	Hash        models.Hash            `json:"hash"`
@@ -195,6 +198,25 @@ type Transaction struct {
 	To          models.Address         `json:"to"`
 	Type        models.TransactionType `json:"type"`
 	Value       *big.Int               `json:"value"`
+	// Receipt fields are added in version 1. They are optional to be able to decode records of the previous version
+	ContractAddress  models.Address `json:"contractAddress,omitempty" rlp:"optional"`
+	GasPrice         models.Uint64  `json:"gasPrice" rlp:"optional"`
+	GasUsed          models.Uint64  `json:"gasUsed" rlp:"optional"`
+	Nonce            models.Uint64  `json:"nonce" rlp:"optional"`
+	TransactionIndex models.Uint64  `json:"transactionIndex" rlp:"optional"`
+	Version          uint8          `json:"-" rlp:"optional"`
+	// InclusionLatency is the time in seconds from the first DAG block that included the transaction to the finalization
+	InclusionLatency models.Uint64 `json:"inclusionLatency,omitempty" rlp:"optional"`
+	DagInclusions    models.Uint64 `json:"dagInclusions,omitempty" rlp:"optional"`
+	// RevertReason is decoded from the output of the failed transaction trace
+	RevertReason string `json:"revertReason,omitempty" rlp:"optional"`
+	// Method is the signature of the called contract method decoded on indexing
+	Method string `json:"method,omitempty" rlp:"optional"`
+}
+
+// IsInternal returns true for transactions that were extracted from traces
+func (t *Transaction) IsInternal() bool {
+	return t.Type >= models.InternalTransfer
 }
 
 // func (t *Transaction) ToModel() models.Transaction {
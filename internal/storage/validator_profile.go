@@ -0,0 +1,153 @@
+package storage
+
+import (
+	"strings"
+	"sync"
+
+	"github.com/dailycrypto-me/daily-indexer/internal/events"
+	"github.com/dailycrypto-me/daily-indexer/models"
+	"github.com/ethereum/go-ethereum/rlp"
+	log "github.com/sirupsen/logrus"
+)
+
+// ValidatorProfile is the current owner, commission and info of the validator set in DPOS contract
+type ValidatorProfile models.ValidatorProfile
+
+// ValidatorEvent is used to select prefix of validator profile change events by validator
+type ValidatorEvent models.ValidatorEvent
+
+// ValidatorProfilesMap keeps validator profiles changed in the block along with the events that changed them
+type ValidatorProfilesMap struct {
+	m        sync.Mutex
+	profiles map[string]*ValidatorProfile
+	events   map[string]models.ValidatorEvent
+}
+
+func MakeValidatorProfilesMap() *ValidatorProfilesMap {
+	return &ValidatorProfilesMap{
+		profiles: make(map[string]*ValidatorProfile),
+		events:   make(map[string]models.ValidatorEvent),
+	}
+}
+
+func (v *ValidatorProfilesMap) get(s Storage, validator string) *ValidatorProfile {
+	validator = strings.ToLower(validator)
+	if profile := v.profiles[validator]; profile != nil {
+		return profile
+	}
+	profile := s.GetValidatorProfile(validator)
+	if profile == nil {
+		profile = &ValidatorProfile{Address: validator}
+	}
+	v.profiles[validator] = profile
+	return profile
+}
+
+// AddProfile is used to add validators that were registered without DPOS events(in genesis)
+func (v *ValidatorProfilesMap) AddProfile(s Storage, e events.LogValidator, blockNumber, timestamp uint64) {
+	v.m.Lock()
+	defer v.m.Unlock()
+	v.apply(s, e, blockNumber, timestamp)
+}
+
+// UpdateEvents applies validator profile changes from DPOS contract events. Values that aren't included in events are decoded from the transaction input
+func (v *ValidatorProfilesMap) UpdateEvents(s Storage, blockNumber, timestamp uint64, from, input string, logs []models.EventLog) error {
+	if len(logs) == 0 {
+		return nil
+	}
+	validatorEvents, err := events.DecodeValidatorTopics(from, input, logs)
+	if err != nil {
+		return err
+	}
+
+	v.m.Lock()
+	defer v.m.Unlock()
+	for _, e := range validatorEvents {
+		v.apply(s, e, blockNumber, timestamp)
+	}
+	return nil
+}
+
+func (v *ValidatorProfilesMap) apply(s Storage, e events.LogValidator, blockNumber, timestamp uint64) {
+	profile := v.get(s, e.Validator)
+	event := models.ValidatorEvent{
+		Type:            e.EventName,
+		Validator:       profile.Address,
+		Changes:         make([]string, 0, 4),
+		Commission:      e.Commission,
+		Description:     e.Description,
+		Endpoint:        e.Endpoint,
+		BlockNumber:     blockNumber,
+		Timestamp:       timestamp,
+		TransactionHash: e.TransactionHash,
+	}
+	if e.EventName == events.ValidatorRegisteredEvent {
+		profile.RegistrationBlock = blockNumber
+		profile.RegistrationTimestamp = timestamp
+		profile.LastCommissionChangeBlock = blockNumber
+	}
+	if e.Owner != nil {
+		profile.Owner = *e.Owner
+		event.Owner = *e.Owner
+		event.Changes = append(event.Changes, "owner")
+	}
+	if e.Commission != nil {
+		if *e.Commission != profile.Commission || e.EventName == events.ValidatorRegisteredEvent {
+			event.Changes = append(event.Changes, "commission")
+		}
+		profile.Commission = *e.Commission
+		profile.LastCommissionChangeBlock = blockNumber
+	}
+	if e.Description != nil {
+		if *e.Description != profile.Description || e.EventName == events.ValidatorRegisteredEvent {
+			event.Changes = append(event.Changes, "description")
+		}
+		profile.Description = *e.Description
+	}
+	if e.Endpoint != nil {
+		if *e.Endpoint != profile.Endpoint || e.EventName == events.ValidatorRegisteredEvent {
+			event.Changes = append(event.Changes, "endpoint")
+		}
+		profile.Endpoint = *e.Endpoint
+	}
+	profile.LastUpdateBlock = blockNumber
+
+	key := FormatLogKey(profile.Address, blockNumber, e.TransactionIndex, e.LogIndex)
+	v.events[key] = event
+}
+
+func (v *ValidatorProfilesMap) AddToBatch(b Batch) {
+	v.m.Lock()
+	defer v.m.Unlock()
+	for address, profile := range v.profiles {
+		b.AddSingleKey(profile, address)
+	}
+	for key, event := range v.events {
+		b.AddSingleKey(ValidatorEvent(event), key)
+	}
+}
+
+// GetValidatorEventsPage returns profile change events of the validator starting from the latest
+func GetValidatorEventsPage(s Storage, validator string, from, count uint64) (ret *models.ValidatorEventsPaginatedResponse) {
+	ret = &models.ValidatorEventsPaginatedResponse{Start: from, Data: make([]models.ValidatorEvent, 0, count)}
+	skipped := uint64(0)
+	s.ForEachBackwards(new(ValidatorEvent), validator, nil, func(_, res []byte) (stop bool) {
+		if skipped < from {
+			skipped++
+			return false
+		}
+		if uint64(len(ret.Data)) == count {
+			ret.HasNext = true
+			return true
+		}
+		var e models.ValidatorEvent
+		err := rlp.DecodeBytes(res, &e)
+		if err != nil {
+			log.WithError(err).Fatal("Error decoding validator event from db")
+		}
+		ret.Data = append(ret.Data, e)
+		return false
+	})
+	ret.End = from + uint64(len(ret.Data))
+	return
+}
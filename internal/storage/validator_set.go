@@ -0,0 +1,185 @@
+package storage
+
+import (
+	"math/big"
+	"sort"
+	"strings"
+
+	"github.com/dailycrypto-me/daily-indexer/models"
+	"github.com/ethereum/go-ethereum/rlp"
+	log "github.com/sirupsen/logrus"
+)
+
+// ValidatorSetCheckpointInterval is the number of blocks between full validator set snapshots. Set at any block is restored from the checkpoint and diffs after it
+const ValidatorSetCheckpointInterval = 10000
+
+// ValidatorStake is the total stake of the validator. Zero stake in the diff means that the validator left the set
+type ValidatorStake struct {
+	Address string
+	Stake   *big.Int
+}
+
+// ValidatorSet is the full set of validators with their stakes. The last set is used to calculate diffs
+type ValidatorSet struct {
+	Block      uint64
+	Validators []ValidatorStake
+}
+
+// ValidatorSetCheckpoint is used to select prefix of the validator sets saved every ValidatorSetCheckpointInterval blocks
+type ValidatorSetCheckpoint ValidatorSet
+
+// ValidatorSetDiff is the list of validators which stake was changed, that joined or left the set in the block
+type ValidatorSetDiff struct {
+	Block     uint64
+	Timestamp uint64
+	Changes   []ValidatorStake
+}
+
+// ValidatorStakeChange is saved to the index by validator for every change of its stake
+type ValidatorStakeChange struct {
+	Block         uint64
+	Timestamp     uint64
+	Stake         *big.Int
+	PreviousStake *big.Int
+}
+
+func (v *ValidatorSet) toMap() map[string]*big.Int {
+	ret := make(map[string]*big.Int, len(v.Validators))
+	for _, validator := range v.Validators {
+		ret[validator.Address] = validator.Stake
+	}
+	return ret
+}
+
+func makeValidatorSet(block uint64, stakes map[string]*big.Int) ValidatorSet {
+	ret := ValidatorSet{Block: block, Validators: make([]ValidatorStake, 0, len(stakes))}
+	for address, stake := range stakes {
+		ret.Validators = append(ret.Validators, ValidatorStake{Address: address, Stake: stake})
+	}
+	sort.Slice(ret.Validators, func(i, j int) bool {
+		return ret.Validators[i].Address < ret.Validators[j].Address
+	})
+	return ret
+}
+
+// activeStakes returns stakes of the validators with non zero stake by lowercase address
+func activeStakes(stakes map[string]*big.Int) map[string]*big.Int {
+	ret := make(map[string]*big.Int, len(stakes))
+	for address, stake := range stakes {
+		if stake != nil && stake.Sign() > 0 {
+			ret[strings.ToLower(address)] = stake
+		}
+	}
+	return ret
+}
+
+// SeedValidatorSet saves the set as the last one and as a checkpoint without diff and stake change records. It is used for the database
+// indexed before the sets were saved, so validators aren't recorded as joined at the first indexed block
+func SeedValidatorSet(b Batch, block uint64, stakes map[string]*big.Int) ValidatorSet {
+	set := makeValidatorSet(block, activeStakes(stakes))
+	b.AddSingleKey(&set, "")
+	b.AddSingleKey(ValidatorSetCheckpoint(set), FormatIntToKey(block))
+	return set
+}
+
+// UpdateValidatorSet saves diff between the last saved set and the set of the block. Full set is saved at checkpoint blocks
+func UpdateValidatorSet(s Storage, b Batch, block, timestamp uint64, stakes map[string]*big.Int) {
+	current := activeStakes(stakes)
+	last := s.GetLastValidatorSet()
+	previous := last.toMap()
+
+	diff := ValidatorSetDiff{Block: block, Timestamp: timestamp, Changes: make([]ValidatorStake, 0)}
+	addChange := func(address string, stake, previousStake *big.Int) {
+		diff.Changes = append(diff.Changes, ValidatorStake{Address: address, Stake: stake})
+		b.Add(&ValidatorStakeChange{Block: block, Timestamp: timestamp, Stake: stake, PreviousStake: previousStake}, address, block)
+	}
+	for address, stake := range current {
+		previousStake, ok := previous[address]
+		if !ok {
+			addChange(address, stake, big.NewInt(0))
+		} else if previousStake.Cmp(stake) != 0 {
+			addChange(address, stake, previousStake)
+		}
+	}
+	for address, previousStake := range previous {
+		if _, ok := current[address]; !ok {
+			addChange(address, big.NewInt(0), previousStake)
+		}
+	}
+
+	set := makeValidatorSet(block, current)
+	if len(diff.Changes) > 0 {
+		sort.Slice(diff.Changes, func(i, j int) bool {
+			return diff.Changes[i].Address < diff.Changes[j].Address
+		})
+		b.AddSingleKey(&diff, FormatIntToKey(block))
+		b.AddSingleKey(&set, "")
+	}
+	if block%ValidatorSetCheckpointInterval == 0 {
+		b.AddSingleKey(ValidatorSetCheckpoint(set), FormatIntToKey(block))
+	}
+}
+
+// GetValidatorSet returns validators with their stakes at the specified block. It is restored from the last checkpoint before the block and diffs saved after it
+func GetValidatorSet(s Storage, block uint64) ValidatorSet {
+	// there is no checkpoint if sets weren't indexed at that block yet, so all diffs are applied
+	from := uint64(0)
+	stakes := make(map[string]*big.Int)
+	if checkpoint := s.GetValidatorSetCheckpoint(block); checkpoint != nil {
+		stakes = checkpoint.toMap()
+		from = checkpoint.Block + 1
+	}
+	s.ForEach(new(ValidatorSetDiff), "", &from, func(_, res []byte) (stop bool) {
+		var diff ValidatorSetDiff
+		err := rlp.DecodeBytes(res, &diff)
+		if err != nil {
+			log.WithError(err).Fatal("Error decoding validator set diff from db")
+		}
+		if diff.Block > block {
+			return true
+		}
+		for _, change := range diff.Changes {
+			if change.Stake.Sign() == 0 {
+				delete(stakes, change.Address)
+			} else {
+				stakes[change.Address] = change.Stake
+			}
+		}
+		return false
+	})
+	return makeValidatorSet(block, stakes)
+}
+
+func (v *ValidatorSet) ToModel() models.ValidatorSet {
+	ret := models.ValidatorSet{Block: v.Block, Validators: make([]models.ValidatorStake, 0, len(v.Validators))}
+	total := big.NewInt(0)
+	for _, validator := range v.Validators {
+		total.Add(total, validator.Stake)
+		ret.Validators = append(ret.Validators, models.ValidatorStake{Address: validator.Address, Stake: validator.Stake.String()})
+	}
+	ret.TotalStake = total.String()
+	return ret
+}
+
+// GetValidatorStakeHistory returns changes of the validator stake in the [from, to] blocks range
+func GetValidatorStakeHistory(s Storage, validator string, from, to uint64) []models.ValidatorStakeChange {
+	ret := make([]models.ValidatorStakeChange, 0)
+	s.ForEach(new(ValidatorStakeChange), validator, &from, func(_, res []byte) (stop bool) {
+		var change ValidatorStakeChange
+		err := rlp.DecodeBytes(res, &change)
+		if err != nil {
+			log.WithError(err).Fatal("Error decoding validator stake change from db")
+		}
+		if change.Block > to {
+			return true
+		}
+		ret = append(ret, models.ValidatorStakeChange{
+			Block:     change.Block,
+			Timestamp: change.Timestamp,
+			Stake:     change.Stake.String(),
+			Delta:     big.NewInt(0).Sub(change.Stake, change.PreviousStake).String(),
+		})
+		return false
+	})
+	return ret
+}
@@ -0,0 +1,90 @@
+package storage
+
+import (
+	"strings"
+
+	"github.com/dailycrypto-me/daily-indexer/models"
+	"github.com/ethereum/go-ethereum/rlp"
+	log "github.com/sirupsen/logrus"
+)
+
+const secondsInDay = 24 * 60 * 60
+
+// VoteStats is the validator cert votes participation in the time window. Missed periods and participation rate aren't stored
+type VoteStats models.VoteStats
+
+// DailyVoteStats is used to select prefix of the vote stats aggregated by days
+type DailyVoteStats VoteStats
+
+// WeeklyVoteStats is used to select prefix of the vote stats aggregated by ISO weeks
+type WeeklyVoteStats VoteStats
+
+// GetDayStart returns timestamp of the UTC day start
+func GetDayStart(timestamp uint64) uint64 {
+	return timestamp - timestamp%secondsInDay
+}
+
+// GetWeekStart returns timestamp of the UTC ISO week start(Monday). Unix epoch started on Thursday
+func GetWeekStart(timestamp uint64) uint64 {
+	day := timestamp / secondsInDay
+	return (day - (day+3)%7) * secondsInDay
+}
+
+// AddPeriodVotes adds cert votes of the period to daily and weekly stats of the validators.
+// Every validator that was eligible or voted in the period is counted as eligible
+func AddPeriodVotes(s Storage, b Batch, timestamp uint64, eligible []string, votes map[string]uint64) {
+	validators := make(map[string]bool, len(eligible))
+	for _, address := range eligible {
+		validators[strings.ToLower(address)] = true
+	}
+	for address := range votes {
+		validators[strings.ToLower(address)] = true
+	}
+
+	dayStart, weekStart := GetDayStart(timestamp), GetWeekStart(timestamp)
+	for address := range validators {
+		weight, voted := votes[address]
+		daily := s.GetVoteStats(new(DailyVoteStats), address, dayStart)
+		daily.addPeriod(voted, weight)
+		b.Add(DailyVoteStats(daily), address, dayStart)
+
+		weekly := s.GetVoteStats(new(WeeklyVoteStats), address, weekStart)
+		weekly.addPeriod(voted, weight)
+		b.Add(WeeklyVoteStats(weekly), address, weekStart)
+	}
+}
+
+// GetVoteStats returns vote stats of the validator for windows started in the [from, to] range. o selects daily or weekly stats
+func GetVoteStats(s Storage, o interface{}, validator string, from, to uint64) []models.VoteStats {
+	ret := make([]models.VoteStats, 0)
+	s.ForEach(o, validator, &from, func(_, res []byte) (stop bool) {
+		var stats VoteStats
+		err := rlp.DecodeBytes(res, &stats)
+		if err != nil {
+			log.WithError(err).Fatal("Error decoding vote stats from db")
+		}
+		if stats.Start > to {
+			return true
+		}
+		ret = append(ret, stats.ToModel())
+		return false
+	})
+	return ret
+}
+
+func (v *VoteStats) addPeriod(voted bool, weight uint64) {
+	v.EligiblePeriods++
+	if voted {
+		v.VotedPeriods++
+		v.VoteWeight += weight
+	}
+}
+
+func (v *VoteStats) ToModel() models.VoteStats {
+	ret := models.VoteStats(*v)
+	ret.MissedPeriods = ret.EligiblePeriods - ret.VotedPeriods
+	if ret.EligiblePeriods > 0 {
+		ret.ParticipationRate = float64(ret.VotedPeriods) / float64(ret.EligiblePeriods)
+	}
+	return ret
+}
@@ -1,17 +1,44 @@
 package storage
 
 import (
+	"math/big"
 	"sort"
+	"strconv"
+	"strings"
 
 	"github.com/dailycrypto-me/daily-indexer/models"
+	"github.com/ethereum/go-ethereum/rlp"
+	log "github.com/sirupsen/logrus"
 )
 
 type WeekStats struct {
 	Validators []models.Validator
 	Total      uint32
-	Key        []byte `rlp:"-"`
+	// Metrics are optional to decode stats saved before they were added
+	Metrics []ValidatorWeekMetrics `rlp:"optional"`
+	Key     []byte                 `rlp:"-"`
 }
 
+// ValidatorWeekMetrics is the activity of the validator in the week in addition to the PBFT blocks count. Stake is the latest stake of the validator in the week
+type ValidatorWeekMetrics struct {
+	Address   string
+	DagsCount uint64
+	// metrics below are optional to decode metrics saved before they were added
+	RewardableDagsCount uint64   `rlp:"optional"`
+	VoteWeight          uint64   `rlp:"optional"`
+	Stake               *big.Int `rlp:"optional"`
+	Rewards             *big.Int `rlp:"optional"`
+}
+
+// Validators sorting options of the leaderboard
+const (
+	SortByPbft    = "pbft"
+	SortByDags    = "dags"
+	SortByStake   = "stake"
+	SortByYield   = "yield"
+	SortByRewards = "rewards"
+)
+
 func MakeEmptyWeekStats() *WeekStats {
 	data := new(WeekStats)
 	return data
@@ -34,9 +61,125 @@ func (w *WeekStats) AddPbftBlock(block *models.Pbft) {
 	w.Validators = append(w.Validators, models.Validator{Address: block.Author, PbftCount: 1})
 }
 
-func (w *WeekStats) GetPaginated(from, count uint64) ([]models.Validator, *models.PaginatedResponse) {
+// GetMetrics returns metrics of the validator, they are added to the week stats if validator wasn't active in the week yet
+func (w *WeekStats) GetMetrics(address string) *ValidatorWeekMetrics {
+	address = strings.ToLower(address)
+	if m := w.findMetrics(address); m != nil {
+		return m
+	}
+	w.Metrics = append(w.Metrics, ValidatorWeekMetrics{Address: address, Stake: big.NewInt(0), Rewards: big.NewInt(0)})
+	return &w.Metrics[len(w.Metrics)-1]
+}
+
+func (w *WeekStats) findMetrics(address string) *ValidatorWeekMetrics {
+	for k := range w.Metrics {
+		if w.Metrics[k].Address == address {
+			if w.Metrics[k].Stake == nil {
+				w.Metrics[k].Stake = big.NewInt(0)
+			}
+			if w.Metrics[k].Rewards == nil {
+				w.Metrics[k].Rewards = big.NewInt(0)
+			}
+			return &w.Metrics[k]
+		}
+	}
+	return nil
+}
+
+// AddPeriodDags adds DAG blocks of the period to the producers metrics
+func (w *WeekStats) AddPeriodDags(dags map[string]uint64) {
+	for address, count := range dags {
+		w.GetMetrics(address).DagsCount += count
+	}
+}
+
+// AddPeriodMetrics adds DAG blocks, votes weight and distributed rewards of the period to the validators metrics. Stakes are updated for validators active in the week
+func (w *WeekStats) AddPeriodMetrics(author string, dags map[string]uint64, stats *RewardsStats, rewards map[string]*ValidatorRewards, stakes map[string]*big.Int) {
+	w.GetMetrics(author)
+	w.AddPeriodDags(dags)
+	if stats != nil {
+		for _, v := range stats.ValidatorsStats {
+			m := w.GetMetrics(v.Address)
+			m.RewardableDagsCount += v.DagBlocksCount
+			m.VoteWeight += v.VoteWeight
+		}
+	}
+	for address, r := range rewards {
+		m := w.GetMetrics(address)
+		m.Rewards.Add(m.Rewards, r.Total())
+	}
+	for address, stake := range stakes {
+		if m := w.findMetrics(strings.ToLower(address)); m != nil && stake != nil {
+			m.Stake = stake
+		}
+	}
+}
+
+// GetValidators returns validators that produced PBFT blocks in the week along with their metrics
+func (w *WeekStats) GetValidators() []models.Validator {
+	ret := make([]models.Validator, 0, len(w.Validators))
+	for _, v := range w.Validators {
+		v.Stake, v.Rewards = "0", "0"
+		if m := w.findMetrics(strings.ToLower(v.Address)); m != nil {
+			v.DagsCount = m.DagsCount
+			v.RewardableDagsCount = m.RewardableDagsCount
+			v.VoteWeight = m.VoteWeight
+			v.Stake = m.Stake.String()
+			v.Rewards = m.Rewards.String()
+		}
+		ret = append(ret, v)
+	}
+	return ret
+}
+
+func parseBigInt(s string) *big.Int {
+	ret, ok := big.NewInt(0).SetString(s, 10)
+	if !ok {
+		return big.NewInt(0)
+	}
+	return ret
+}
+
+func parseYield(s string) float64 {
+	ret, err := strconv.ParseFloat(s, 64)
+	if err != nil {
+		return 0
+	}
+	return ret
+}
+
+// SortValidators sorts validators by the metric and sets their ranks. Rank 1 is always the validator with the highest metric value, so ascending order just reverses the list
+func SortValidators(validators []models.Validator, sortBy string, ascending bool) {
+	var greater func(a, b *models.Validator) bool
+	switch sortBy {
+	case SortByDags:
+		greater = func(a, b *models.Validator) bool { return a.DagsCount > b.DagsCount }
+	case SortByStake:
+		greater = func(a, b *models.Validator) bool { return parseBigInt(a.Stake).Cmp(parseBigInt(b.Stake)) > 0 }
+	case SortByYield:
+		greater = func(a, b *models.Validator) bool { return parseYield(a.Yield) > parseYield(b.Yield) }
+	case SortByRewards:
+		greater = func(a, b *models.Validator) bool { return parseBigInt(a.Rewards).Cmp(parseBigInt(b.Rewards)) > 0 }
+	default:
+		greater = func(a, b *models.Validator) bool { return a.PbftCount > b.PbftCount }
+	}
+	sort.SliceStable(validators, func(i, j int) bool {
+		return greater(&validators[i], &validators[j])
+	})
+	for k := range validators {
+		validators[k].Rank = uint64(k + 1)
+	}
+	if ascending {
+		for i, j := 0, len(validators)-1; i < j; i, j = i+1, j-1 {
+			validators[i], validators[j] = validators[j], validators[i]
+		}
+	}
+}
+
+// PaginateValidators returns the page of already sorted validators
+func PaginateValidators(all []models.Validator, from, count uint64) ([]models.Validator, *models.PaginatedResponse) {
 	pagination := new(models.PaginatedResponse)
-	pagination.Total = uint64(len(w.Validators))
+	pagination.Total = uint64(len(all))
 	if from > pagination.Total {
 		from = pagination.Total
 	}
@@ -48,13 +191,76 @@ func (w *WeekStats) GetPaginated(from, count uint64) ([]models.Validator, *model
 	}
 	pagination.End = end
 
-	w.Sort()
 	var validators []models.Validator
+	validators = append(validators, all[from:end]...)
 
-	for k, v := range w.Validators[from:end] {
-		v.Rank = uint64(k + 1)
-		validators = append(validators, v)
+	return validators, pagination
+}
+
+func (w *WeekStats) GetPaginated(from, count uint64) ([]models.Validator, *models.PaginatedResponse) {
+	validators := w.GetValidators()
+	SortValidators(validators, SortByPbft, false)
+	return PaginateValidators(validators, from, count)
+}
+
+// ValidatorWeekStats is used to select prefix of the weekly stats index by validator. Stats are saved with FormatWeek key
+type ValidatorWeekStats models.ValidatorWeekStats
+
+// FormatWeek returns week number in the same format that is used for week stats keys, e.g. 202412
+func FormatWeek(year, week int32) uint64 {
+	return uint64(year)*100 + uint64(week)
+}
+
+func getShare(pbftCount, total uint64) float64 {
+	if total == 0 {
+		return 0
 	}
+	return float64(pbftCount) / float64(total)
+}
 
-	return validators, pagination
+// GetValidatorsWeekStats returns stats of all validators that produced PBFT blocks in the week sorted by rank
+func (w *WeekStats) GetValidatorsWeekStats(year, week int32) []models.ValidatorWeekStats {
+	w.Sort()
+	ret := make([]models.ValidatorWeekStats, 0, len(w.Validators))
+	for k, v := range w.Validators {
+		ret = append(ret, models.ValidatorWeekStats{
+			Year:           uint32(year),
+			Week:           uint32(week),
+			Rank:           uint64(k + 1),
+			PbftCount:      v.PbftCount,
+			TotalPbftCount: uint64(w.Total),
+			Share:          getShare(v.PbftCount, uint64(w.Total)),
+		})
+	}
+	return ret
+}
+
+// AddWeekToValidatorsIndex saves stats of the finished week to the index by validator. Yield is set only if getYield is specified
+func AddWeekToValidatorsIndex(b Batch, w *WeekStats, year, week int32, getYield func(address string) string) {
+	for k, stats := range w.GetValidatorsWeekStats(year, week) {
+		address := w.Validators[k].Address
+		if getYield != nil {
+			stats.Yield = getYield(address)
+		}
+		b.Add(ValidatorWeekStats(stats), address, FormatWeek(year, week))
+	}
+}
+
+// GetValidatorHistory returns weekly stats of the validator from the index for weeks in the [fromWeek, toWeek] range
+func GetValidatorHistory(s Storage, validator string, fromWeek, toWeek uint64) []models.ValidatorWeekStats {
+	ret := make([]models.ValidatorWeekStats, 0)
+	s.ForEach(new(ValidatorWeekStats), validator, &fromWeek, func(_, res []byte) (stop bool) {
+		var stats models.ValidatorWeekStats
+		err := rlp.DecodeBytes(res, &stats)
+		if err != nil {
+			log.WithError(err).Fatal("Error decoding validator week stats from db")
+		}
+		if FormatWeek(int32(stats.Year), int32(stats.Week)) > toWeek {
+			return true
+		}
+		stats.Share = getShare(stats.PbftCount, stats.TotalPbftCount)
+		ret = append(ret, stats)
+		return false
+	})
+	return ret
 }
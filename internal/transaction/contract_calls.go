@@ -0,0 +1,165 @@
+package transaction
+
+import (
+	"bytes"
+	"strings"
+
+	"github.com/dailycrypto-me/daily-indexer/internal/contracts"
+	"github.com/dailycrypto-me/daily-indexer/internal/storage"
+	"github.com/dailycrypto-me/daily-indexer/models"
+	"github.com/ethereum/go-ethereum/rlp"
+	log "github.com/sirupsen/logrus"
+)
+
+const reindexBatchLimit = 10000
+
+// DecodeStoredMethod decodes the stored contract call that wasn't decoded on indexing or was guessed by the function signature,
+// so ABIs and signatures added after the call was indexed are applied on read
+func DecodeStoredMethod(trx *storage.Transaction) {
+	if trx.Calldata != nil && (trx.Calldata.Guess == nil || !*trx.Calldata.Guess) {
+		return
+	}
+	decoded := *trx
+	DecodeMethod(&decoded)
+	if decoded.Calldata != nil {
+		trx.Calldata, trx.Method = decoded.Calldata, decoded.Method
+	}
+}
+
+// SaveContractABI saves the ABI and reindexes stored calls of the contract if the ABI was changed, so calls indexed before are decoded with it
+func SaveContractABI(s storage.Storage, r *contracts.Registry, address, abiJSON string) error {
+	previous := r.GetRaw(address)
+	if err := storage.SaveContractABI(s, r, address, abiJSON); err != nil {
+		return err
+	}
+	if r.GetRaw(address) != previous {
+		ReindexContractCalls(s, address)
+	}
+	return nil
+}
+
+// isContractIndexCopy returns true if the key is the copy of the transaction in the address index of the called contract
+func isContractIndexCopy(key []byte, trx *storage.Transaction) bool {
+	suffix := len(storage.FormatIntToKey(0))
+	return len(key) > suffix && bytes.HasSuffix(key[:len(key)-suffix], []byte(strings.ToLower(trx.To)))
+}
+
+// removeMethodIndex removes calls of the contract methods and their counters. Index of all contracts is removed if contract is empty
+func removeMethodIndex(s storage.Storage, contract string) {
+	b := s.NewBatch()
+	remove := func(key, _ []byte) (stop bool) {
+		b.Remove(key)
+		return false
+	}
+	if contract == "" {
+		s.ForEach(new(storage.MethodTransaction), "", nil, remove)
+		s.ForEach(new(storage.ContractMethods), "", nil, remove)
+	} else {
+		for _, m := range s.GetContractMethods(contract).Methods {
+			s.ForEach(new(storage.MethodTransaction), storage.GetMethodKey(contract, m.Method), nil, remove)
+		}
+		b.RemoveSingleKey(new(storage.ContractMethods), contract)
+	}
+	b.CommitBatch()
+}
+
+// ReindexContractCalls decodes contract calls saved in the address index and rebuilds the method index from them. All records are processed
+// if contract is empty, otherwise only the calls in the index of the contract and their copies saved by hash and in internal transactions.
+// Records are iterated in the order they were indexed, so calls are added to the method index from the copies in the called contract index
+// to keep the same order and counters as on indexing. Copies in the sender index aren't updated, they are decoded on read
+func ReindexContractCalls(s storage.Storage, contract string) {
+	storage.MethodIndexMutex.Lock()
+	defer storage.MethodIndexMutex.Unlock()
+
+	contract = strings.ToLower(contract)
+	removeMethodIndex(s, contract)
+
+	methods := storage.MakeContractMethodsMap()
+	b := s.NewBatch()
+	count, total := 0, 0
+	commit := func(force bool) {
+		if count < reindexBatchLimit && !force {
+			return
+		}
+		b.CommitBatch()
+		b = s.NewBatch()
+		total += count
+		count = 0
+		log.WithFields(log.Fields{"contract": contract, "records": total}).Info("ReindexContractCalls: Decoded contract calls")
+	}
+	decodeInternal := func(internal *storage.InternalTransactionsResponse) {
+		for i := range internal.Data {
+			if contract == "" || strings.EqualFold(internal.Data[i].To, contract) {
+				DecodeMethod(&internal.Data[i])
+			}
+		}
+		count += len(internal.Data)
+	}
+
+	// call of the contract to itself is saved twice in a row to the contract index, but it is added to the method index once
+	selfCallIndexed := false
+	updatedInternals := make(map[string]bool)
+	s.ForEach(new(storage.Transaction), contract, nil, func(key, res []byte) (stop bool) {
+		var trx storage.Transaction
+		err := rlp.DecodeBytes(res, &trx)
+		if err != nil {
+			log.WithError(err).Fatal("ReindexContractCalls: Error decoding transaction")
+		}
+		if trx.Type != models.ContractCall && trx.Type != models.InternalContractCall {
+			return false
+		}
+		if contract != "" && !strings.EqualFold(trx.To, contract) {
+			return false
+		}
+		DecodeMethod(&trx)
+		if err := b.AddWithKey(trx, key); err != nil {
+			log.WithError(err).Fatal("ReindexContractCalls: Error saving transaction")
+		}
+		count++
+
+		if !isContractIndexCopy(key, &trx) {
+			return false
+		}
+		if trx.Method != "" {
+			selfCall := strings.EqualFold(trx.From, trx.To)
+			if !selfCall || !selfCallIndexed {
+				index := methods.AddCall(s, trx.To, trx.Method)
+				b.Add(storage.MethodTransaction(trx), storage.GetMethodKey(trx.To, trx.Method), index)
+			}
+			selfCallIndexed = selfCall && !selfCallIndexed
+		}
+		// records saved by hash are iterated as well if all records are processed
+		if contract != "" {
+			if !trx.IsInternal() {
+				b.AddSingleKey(trx, trx.Hash)
+			} else if !updatedInternals[trx.Hash] {
+				updatedInternals[trx.Hash] = true
+				internal := s.GetInternalTransactions(trx.Hash)
+				decodeInternal(&internal)
+				b.AddSingleKey(&internal, trx.Hash)
+			}
+		}
+		commit(false)
+		return false
+	})
+	methods.AddToBatch(b)
+	commit(true)
+
+	if contract == "" {
+		s.ForEach(new(storage.InternalTransactionsResponse), "", nil, func(key, res []byte) (stop bool) {
+			var internal storage.InternalTransactionsResponse
+			err := rlp.DecodeBytes(res, &internal)
+			if err != nil {
+				log.WithError(err).Fatal("ReindexContractCalls: Error decoding internal transactions")
+			}
+			decodeInternal(&internal)
+			if err := b.AddWithKey(internal, key); err != nil {
+				log.WithError(err).Fatal("ReindexContractCalls: Error saving internal transactions")
+			}
+			commit(false)
+			return false
+		})
+		commit(true)
+	}
+	log.WithFields(log.Fields{"contract": contract, "records": total}).Info("ReindexContractCalls: Finished decoding contract calls")
+}
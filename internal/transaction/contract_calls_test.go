@@ -0,0 +1,33 @@
+package transaction
+
+import (
+	"testing"
+
+	"github.com/dailycrypto-me/daily-indexer/internal/contracts"
+	"github.com/dailycrypto-me/daily-indexer/internal/storage"
+	"github.com/dailycrypto-me/daily-indexer/models"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDecodeStoredMethod(t *testing.T) {
+	defaultAbis := contracts.Abis
+	contracts.Abis = contracts.MakeDefaultRegistry()
+	t.Cleanup(func() { contracts.Abis = defaultAbis })
+
+	contract := "0x0000000000000000000000000000000000000abe"
+	trx := storage.Transaction{To: contract, Type: models.ContractCall, Input: "0xa9059cbb000000000000000000000000ed4d5f4f3641cbc056e466d15dbe2403e38056f800000000000000000000000000000000000000000000000000000000000003e8"}
+	DecodeMethod(&trx)
+	assert.True(t, *trx.Calldata.Guess)
+
+	// guessed call is decoded with ABI registered later
+	_, err := contracts.Abis.Register(contract, `[{"inputs":[{"name":"to","type":"address"},{"name":"amount","type":"uint256"}],"name":"transfer","outputs":[],"stateMutability":"nonpayable","type":"function"}]`)
+	assert.NoError(t, err)
+	DecodeStoredMethod(&trx)
+	assert.Nil(t, trx.Calldata.Guess)
+	assert.Equal(t, "transfer(address,uint256)", trx.Method)
+
+	// call that can't be decoded keeps the stored calldata
+	unknown := storage.Transaction{To: "0x0000000000000000000000000000000000000abf", Type: models.ContractCall, Input: "0x12345678", Method: "stored()", Calldata: &storage.Calldata{Name: "stored()"}}
+	DecodeStoredMethod(&unknown)
+	assert.Equal(t, "stored()", unknown.Method)
+}
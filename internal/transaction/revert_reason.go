@@ -0,0 +1,87 @@
+package transaction
+
+import (
+	"bytes"
+	"encoding/hex"
+	"fmt"
+	"strings"
+
+	"github.com/dailycrypto-me/daily-indexer/internal/common"
+	"github.com/dailycrypto-me/daily-indexer/internal/contracts"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/crypto"
+)
+
+var (
+	errorSelector = crypto.Keccak256([]byte("Error(string)"))[:4]
+	panicSelector = crypto.Keccak256([]byte("Panic(uint256)"))[:4]
+)
+
+// DecodeRevertReason decodes output of the reverted call. Error(string) returns the message, Panic(uint256) returns the panic description
+// and custom errors are decoded with ABIs of the contracts from the trace or guessed with known signatures.
+// Trace error is returned if output can't be decoded, e.g. for out of gas failures
+func DecodeRevertReason(output, traceError string, addresses []string) string {
+	data, err := hex.DecodeString(strings.TrimPrefix(output, "0x"))
+	if err != nil || len(data) < 4 {
+		return traceError
+	}
+	selector := data[:4]
+	if bytes.Equal(selector, errorSelector) || bytes.Equal(selector, panicSelector) {
+		reason, err := abi.UnpackRevert(data)
+		if err != nil {
+			return traceError
+		}
+		if bytes.Equal(selector, panicSelector) {
+			return "panic: " + reason
+		}
+		return reason
+	}
+	if reason := decodeCustomError(data, addresses); reason != "" {
+		return reason
+	}
+	if traceError != "" {
+		return traceError
+	}
+	return fmt.Sprintf("unknown error 0x%x", selector)
+}
+
+// decodeCustomError looks for the error by selector in ABIs of the specified contracts. Error could be bubbled up from the nested call, so all contracts are checked
+func decodeCustomError(data []byte, addresses []string) string {
+	var id [4]byte
+	copy(id[:], data[:4])
+	for _, address := range addresses {
+		contractABI := contracts.Abis.Get(address)
+		if contractABI == nil {
+			continue
+		}
+		customError, err := contractABI.ErrorByID(id)
+		if err != nil {
+			continue
+		}
+		unpacked, err := customError.Inputs.Unpack(data[4:])
+		if err != nil {
+			return customError.Sig
+		}
+		return formatError(customError.Name, unpacked)
+	}
+	signature, unpacked, err := contracts.Signatures.DecodeMethod(data)
+	if err != nil || signature == "" {
+		return ""
+	}
+	if unpacked == nil {
+		return signature
+	}
+	return formatError(signature[:strings.Index(signature, "(")], unpacked)
+}
+
+func formatError(name string, unpacked []interface{}) string {
+	params := make([]string, 0, len(unpacked))
+	for _, v := range unpacked {
+		param, err := common.ParseToString(v)
+		if err != nil {
+			param = v
+		}
+		params = append(params, fmt.Sprint(param))
+	}
+	return fmt.Sprintf("%s(%s)", name, strings.Join(params, ", "))
+}
@@ -0,0 +1,50 @@
+package transaction
+
+import (
+	"math/big"
+	"strings"
+	"testing"
+
+	"github.com/dailycrypto-me/daily-indexer/internal/contracts"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/assert"
+)
+
+const customErrorAbi = `[{"inputs":[{"name":"available","type":"uint256"},{"name":"required","type":"uint256"}],"name":"InsufficientBalance","type":"error"}]`
+
+func packRevert(t *testing.T, signature string, args ...interface{}) string {
+	name := signature[:strings.Index(signature, "(")]
+	types := strings.Split(signature[len(name)+1:len(signature)-1], ",")
+	arguments := make(abi.Arguments, 0, len(types))
+	for _, typeName := range types {
+		typ, err := abi.NewType(typeName, "", nil)
+		assert.NoError(t, err)
+		arguments = append(arguments, abi.Argument{Type: typ})
+	}
+	packed, err := arguments.Pack(args...)
+	assert.NoError(t, err)
+	return hexutil.Encode(append(crypto.Keccak256([]byte(signature))[:4], packed...))
+}
+
+func TestDecodeRevertReason(t *testing.T) {
+	assert.Equal(t, "Not enough stake", DecodeRevertReason(packRevert(t, "Error(string)", "Not enough stake"), "Reverted", nil))
+	assert.Equal(t, "panic: arithmetic underflow or overflow", DecodeRevertReason(packRevert(t, "Panic(uint256)", big.NewInt(0x11)), "Reverted", nil))
+
+	// custom error is decoded with ABI of the nested call contract. Test ABI is registered in a copy of the default registry
+	defaultAbis := contracts.Abis
+	contracts.Abis = contracts.MakeDefaultRegistry()
+	t.Cleanup(func() { contracts.Abis = defaultAbis })
+	address := "0x0000000000000000000000000000000000000abc"
+	_, err := contracts.Abis.Register(address, customErrorAbi)
+	assert.NoError(t, err)
+	output := packRevert(t, "InsufficientBalance(uint256,uint256)", big.NewInt(5), big.NewInt(10))
+	assert.Equal(t, "InsufficientBalance(5, 10)", DecodeRevertReason(output, "Reverted", []string{"0x00000000000000000000000000000000000000fe", address}))
+	assert.Equal(t, "Reverted", DecodeRevertReason(output, "Reverted", nil))
+
+	// trace error is used if there is no output
+	assert.Equal(t, "Out of gas", DecodeRevertReason("0x", "Out of gas", nil))
+	assert.Equal(t, "", DecodeRevertReason("", "", nil))
+	assert.Equal(t, "unknown error 0x12345678", DecodeRevertReason("0x12345678", "", nil))
+}
@@ -29,12 +29,8 @@ func decodeTransaction(tx storage.Transaction) (functionSig string, params any,
 	if tx.Input == "" {
 		return
 	}
-	relevantAbi := contracts.ContractABIs[strings.ToLower(tx.To)]
-	if relevantAbi == "" {
-		return
-	}
-	contractABI, err := abi.JSON(strings.NewReader(relevantAbi))
-	if err != nil {
+	contractABI := contracts.Abis.Get(tx.To)
+	if contractABI == nil {
 		return
 	}
 
@@ -51,18 +47,13 @@ func decodeTransaction(tx storage.Transaction) (functionSig string, params any,
 	}
 	// Decode the transaction
 	method, err := contractABI.MethodById(funcId)
-
-	if method == nil {
-		fmt.Println(method, err)
-	}
-
-	functionSig = method.Sig
-
 	if err != nil || method == nil {
 		return
 	}
 
-	unpacked, err := unpackParams(contractABI, method, data)
+	functionSig = method.Sig
+
+	unpacked, err := unpackParams(*contractABI, method, data)
 
 	if err != nil {
 		return
@@ -89,8 +80,35 @@ func unpackParams(contractABI abi.ABI, method *abi.Method, data []byte) ([]inter
 	return unpacked, err
 }
 
+// guessTransaction decodes input of the transaction to the contract without ABI using known function signatures
+func guessTransaction(tx storage.Transaction) (functionSig string, params any, err error) {
+	if tx.Input == "" || tx.Type == models.ContractCreation {
+		return
+	}
+	bytes, err := hex.DecodeString(strings.TrimPrefix(tx.Input, "0x"))
+	if err != nil || len(bytes) < 4 {
+		return
+	}
+
+	functionSig, unpacked, err := contracts.Signatures.DecodeMethod(bytes)
+	if err != nil || unpacked == nil {
+		return
+	}
+
+	params, err = common.ParseToString(unpacked)
+	return
+}
+
 func DecodeTransaction(trx *storage.Transaction) (err error) {
-	sig, params, err := decodeTransaction(*trx)
+	decode, guess := decodeTransaction, false
+	if contracts.Abis.Get(trx.To) == nil {
+		decode, guess = guessTransaction, true
+	}
+	sig, params, err := decode(*trx)
+
+	if guess && sig == "" {
+		return
+	}
 
 	if sig == "" && params != nil {
 		return
@@ -101,10 +119,37 @@ func DecodeTransaction(trx *storage.Transaction) (err error) {
 		return
 	}
 
-	trx.Calldata = &models.CallData{
+	trx.Calldata = &storage.Calldata{
 		Name:   sig,
 		Params: params,
 	}
+	if guess {
+		trx.Calldata.Guess = &guess
+	}
 
 	return
 }
+
+// DecodeMethod sets calldata and signature of the called method to the contract call. It is done on indexing, so calldata is saved with the transaction
+func DecodeMethod(trx *storage.Transaction) {
+	trx.Calldata, trx.Method = nil, ""
+	if trx.Type != models.ContractCall && trx.Type != models.InternalContractCall {
+		return
+	}
+	if err := DecodeTransaction(trx); err == nil && trx.Calldata != nil {
+		trx.Method = trx.Calldata.Name
+	}
+}
+
+// DecodeTraceCall sets method names of the calls in the tree to the contracts with known ABI or function signatures
+func DecodeTraceCall(call *models.TraceCall) {
+	if call.Input != "" && call.Input != "0x" && call.Type != "create" {
+		trx := storage.Transaction{To: call.To, Input: call.Input, Type: models.InternalContractCall}
+		if DecodeTransaction(&trx) == nil && trx.Calldata != nil {
+			call.Method = &trx.Calldata.Name
+		}
+	}
+	for i := range call.Calls {
+		DecodeTraceCall(&call.Calls[i])
+	}
+}
@@ -126,3 +126,23 @@ func TestDecodeTransaction(t *testing.T) {
 		assert.Equal(t, string(expected_json), string(params_json))
 	}
 }
+
+func TestDecodeTransactionWithoutABI(t *testing.T) {
+	tx := storage.Transaction{
+		Hash:  "abc123",
+		From:  "0x99a2d5feaecb1a729d4f9af4197cc03bb9a37bc3",
+		To:    "0xca67d0c50c4f5363aa47a11c54486ad8f28a7f2b",
+		Input: "0xa9059cbb000000000000000000000000ed4d5f4f3641cbc056e466d15dbe2403e38056f800000000000000000000000000000000000000000000000000000000000003e8",
+	}
+
+	err := DecodeTransaction(&tx)
+	assert.NoError(t, err)
+	assert.NotNil(t, tx.Calldata)
+	assert.Equal(t, "transfer(address,uint256)", tx.Calldata.Name)
+	assert.Equal(t, []any{"0xed4d5f4f3641cbc056e466d15dbe2403e38056f8", "1000"}, tx.Calldata.Params)
+	assert.True(t, *tx.Calldata.Guess)
+
+	unknown := storage.Transaction{To: tx.To, Input: "0x12345678"}
+	assert.NoError(t, DecodeTransaction(&unknown))
+	assert.Nil(t, unknown.Calldata)
+}
@@ -15,11 +15,15 @@ import (
 	"github.com/dailycrypto-me/daily-indexer/api"
 	"github.com/dailycrypto-me/daily-indexer/internal/chain"
 	"github.com/dailycrypto-me/daily-indexer/internal/common"
+	"github.com/dailycrypto-me/daily-indexer/internal/contracts"
 	"github.com/dailycrypto-me/daily-indexer/internal/indexer"
 	"github.com/dailycrypto-me/daily-indexer/internal/logging"
 	"github.com/dailycrypto-me/daily-indexer/internal/metrics"
+	"github.com/dailycrypto-me/daily-indexer/internal/storage"
 	"github.com/dailycrypto-me/daily-indexer/internal/storage/pebble"
 	migration "github.com/dailycrypto-me/daily-indexer/internal/storage/pebble/migrations"
+	"github.com/dailycrypto-me/daily-indexer/internal/transaction"
+	"github.com/getkin/kin-openapi/openapi3filter"
 	"github.com/labstack/echo/v4"
 	echomiddleware "github.com/oapi-codegen/echo-middleware"
 	log "github.com/sirupsen/logrus"
@@ -33,8 +37,12 @@ var (
 	log_level                        *string
 	yield_saving_interval            *int
 	validators_yield_saving_interval *int
+	supply_saving_interval           *int
 	sync_queue_limit                 *int
 	chain_stats_interval             *int
+	abi_dir                          *string
+	api_token                        *string
+	locked_addresses                 *string
 )
 
 func init() {
@@ -45,8 +53,12 @@ func init() {
 	log_level = flag.String("log_level", "info", "minimum log level. could be only [trace, debug, info, warn, error, fatal]")
 	yield_saving_interval = flag.Int("yield_saving_interval", 25000, "interval for saving total yield")
 	validators_yield_saving_interval = flag.Int("validators_yield_saving_interval", 25000, "interval for saving validators yield")
+	supply_saving_interval = flag.Int("supply_saving_interval", 25000, "interval for saving supply and staking history")
 	sync_queue_limit = flag.Int("sync_queue_limit", 10, "limit of blocks in the sync queue")
 	chain_stats_interval = flag.Int("chain_stats_interval", 100, "interval for saving chain stats")
+	abi_dir = flag.String("abi_dir", "", "path to directory with contract ABIs named as <contract address>.json")
+	api_token = flag.String("api_token", "", "bearer token for the API endpoints that are modifying data. These endpoints are disabled if empty")
+	locked_addresses = flag.String("locked_addresses", "", "comma separated list of addresses(team, treasury) which balances are subtracted from the circulating supply")
 
 	flag.Parse()
 
@@ -81,28 +93,51 @@ func main() {
 		log.WithError(err).Fatal("Error loading swagger spec")
 	}
 
-	manager := migration.NewManager(st, *blockchain_ws)
+	c := common.DefaultConfig()
+	c.TotalYieldSavingInterval = uint64(*yield_saving_interval)
+	c.ValidatorsYieldSavingInterval = uint64(*validators_yield_saving_interval)
+	c.SupplySavingInterval = uint64(*supply_saving_interval)
+	c.SyncQueueLimit = uint64(*sync_queue_limit)
+	c.ChainStatsInterval = *chain_stats_interval
+	c.LockedAddresses = common.ParseAddressesList(*locked_addresses)
+
+	manager := migration.NewManager(st, *blockchain_ws, c)
 	err = manager.ApplyAll()
 	if err != nil {
 		log.WithError(err).Fatal("Error applying migrations")
 	}
 
+	storage.LoadContractABIs(st, contracts.Abis)
+	storage.LoadSignatures(st, contracts.Signatures)
+	if *abi_dir != "" {
+		abis, err := contracts.ReadABIDir(*abi_dir)
+		if err != nil {
+			log.WithError(err).Fatal("Error reading contract ABIs")
+		}
+		for address, abi := range abis {
+			if err := transaction.SaveContractABI(st, contracts.Abis, address, abi); err != nil {
+				log.WithError(err).WithField("address", address).Fatal("Error loading contract ABI")
+			}
+		}
+		log.WithFields(log.Fields{"dir": *abi_dir, "count": len(abis)}).Info("Loaded contract ABIs from directory")
+	}
+
 	swagger.Servers = nil
 
 	e := echo.New()
 
-	e.Use(echomiddleware.OapiRequestValidator(swagger))
+	e.Use(echomiddleware.OapiRequestValidatorWithOptions(swagger, &echomiddleware.Options{
+		Options: openapi3filter.Options{AuthenticationFunc: api.MakeTokenAuthenticator(*api_token)},
+	}))
 	// Add http error handler to return a proper error JSON on request error
 	e.HTTPErrorHandler = func(err error, ctx echo.Context) {
-		_ = ctx.JSON(http.StatusInternalServerError, map[string]any{"message": err.Error()})
+		code := http.StatusInternalServerError
+		if he, ok := err.(*echo.HTTPError); ok && (he.Code == http.StatusUnauthorized || he.Code == http.StatusForbidden) {
+			code = he.Code
+		}
+		_ = ctx.JSON(code, map[string]any{"message": err.Error()})
 	}
 
-	c := common.DefaultConfig()
-	c.TotalYieldSavingInterval = uint64(*yield_saving_interval)
-	c.ValidatorsYieldSavingInterval = uint64(*validators_yield_saving_interval)
-	c.SyncQueueLimit = uint64(*sync_queue_limit)
-	c.ChainStatsInterval = *chain_stats_interval
-
 	log.WithFields(log.Fields{"pbft_count": fin.PbftCount, "dag_count": fin.DagCount, "trx_count": fin.TrxCount}).Info("Loaded db with")
 	chainStats := chain.MakeStats(c.ChainStatsInterval)
 	apiHandler := api.NewApiHandler(st, c, chainStats)
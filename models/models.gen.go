@@ -3,6 +3,23 @@
 // Code generated by github.com/oapi-codegen/oapi-codegen/v2 version v2.4.1 DO NOT EDIT.
 package models
 
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/oapi-codegen/runtime"
+)
+
+const (
+	ApiTokenScopes = "apiToken.Scopes"
+)
+
+// Defines values for DagEdgeType.
+const (
+	Pivot DagEdgeType = "pivot"
+	Tip   DagEdgeType = "tip"
+)
+
 // Defines values for TransactionType.
 const (
 	ContractCall             TransactionType = 1
@@ -13,6 +30,51 @@ const (
 	Transfer                 TransactionType = 0
 )
 
+// Defines values for ChainStatsWindowParam.
+const (
+	ChainStatsWindowParamN100blocks ChainStatsWindowParam = "100blocks"
+	ChainStatsWindowParamN1h        ChainStatsWindowParam = "1h"
+	ChainStatsWindowParamN24h       ChainStatsWindowParam = "24h"
+	ChainStatsWindowParamN7d        ChainStatsWindowParam = "7d"
+)
+
+// Defines values for GetChainStatsParamsWindow.
+const (
+	GetChainStatsParamsWindowN100blocks GetChainStatsParamsWindow = "100blocks"
+	GetChainStatsParamsWindowN1h        GetChainStatsParamsWindow = "1h"
+	GetChainStatsParamsWindowN24h       GetChainStatsParamsWindow = "24h"
+	GetChainStatsParamsWindowN7d        GetChainStatsParamsWindow = "7d"
+)
+
+// Defines values for GetChainStatsHistoryParamsWindow.
+const (
+	N100blocks GetChainStatsHistoryParamsWindow = "100blocks"
+	N1h        GetChainStatsHistoryParamsWindow = "1h"
+	N24h       GetChainStatsHistoryParamsWindow = "24h"
+	N7d        GetChainStatsHistoryParamsWindow = "7d"
+)
+
+// Defines values for GetValidatorsParamsSortBy.
+const (
+	GetValidatorsParamsSortByDags    GetValidatorsParamsSortBy = "dags"
+	GetValidatorsParamsSortByPbft    GetValidatorsParamsSortBy = "pbft"
+	GetValidatorsParamsSortByRewards GetValidatorsParamsSortBy = "rewards"
+	GetValidatorsParamsSortByStake   GetValidatorsParamsSortBy = "stake"
+	GetValidatorsParamsSortByYield   GetValidatorsParamsSortBy = "yield"
+)
+
+// Defines values for GetValidatorsParamsOrder.
+const (
+	Asc  GetValidatorsParamsOrder = "asc"
+	Desc GetValidatorsParamsOrder = "desc"
+)
+
+// Defines values for GetValidatorVotesParamsWindow.
+const (
+	GetValidatorVotesParamsWindowDay  GetValidatorVotesParamsWindow = "day"
+	GetValidatorVotesParamsWindowWeek GetValidatorVotesParamsWindow = "week"
+)
+
 // Account defines model for Account.
 type Account struct {
 	Address Address `json:"address"`
@@ -30,6 +92,8 @@ type BigInt = string
 
 // CallData defines model for CallData.
 type CallData struct {
+	// Guess True if the contract has no ABI and the method was guessed by its selector
+	Guess  *bool  `json:"guess,omitempty" rlp:"-"`
 	Name   string `json:"name"`
 	Params any    `json:"params"`
 }
@@ -38,10 +102,36 @@ type CallData struct {
 type ChainStats struct {
 	BlockInterval float32 `json:"blockInterval"`
 	EndBlock      Uint64  `json:"endBlock"`
+	EndTimestamp  Uint64  `json:"endTimestamp"`
 	StartBlock    Uint64  `json:"startBlock"`
 	Tps           float32 `json:"tps"`
 }
 
+// ChainStatsHistoryResponse defines model for ChainStatsHistoryResponse.
+type ChainStatsHistoryResponse struct {
+	Data []ChainStats `json:"data"`
+}
+
+// ContractAbi defines model for ContractAbi.
+type ContractAbi = []map[string]interface{}
+
+// ContractAbiArtifact defines model for ContractAbiArtifact.
+type ContractAbiArtifact struct {
+	Abi                  ContractAbi            `json:"abi"`
+	AdditionalProperties map[string]interface{} `json:"-"`
+}
+
+// ContractAbiUpload defines model for ContractAbiUpload.
+type ContractAbiUpload struct {
+	union json.RawMessage
+}
+
+// ContractMethodsResponse defines model for ContractMethodsResponse.
+type ContractMethodsResponse struct {
+	Address Address       `json:"address"`
+	Data    []MethodCount `json:"data"`
+}
+
 // CountResponse defines model for CountResponse.
 type CountResponse struct {
 	Total Uint64 `json:"total"`
@@ -55,13 +145,147 @@ type Dag struct {
 	TransactionCount Uint64 `json:"transactionCount"`
 }
 
+// DagEdge defines model for DagEdge.
+type DagEdge struct {
+	From Hash        `json:"from"`
+	To   Hash        `json:"to"`
+	Type DagEdgeType `json:"type"`
+}
+
+// DagEdgeType defines model for DagEdge.Type.
+type DagEdgeType string
+
+// DagGraph defines model for DagGraph.
+type DagGraph struct {
+	Edges  []DagEdge `json:"edges"`
+	Nodes  []DagNode `json:"nodes"`
+	Period Uint64    `json:"period"`
+}
+
+// DagNode defines model for DagNode.
+type DagNode struct {
+	Hash  Hash   `json:"hash"`
+	Level Uint64 `json:"level"`
+
+	// Order Position of the block in the period finalization order
+	Order            Uint64  `json:"order"`
+	Pivot            Hash    `json:"pivot"`
+	Sender           Address `json:"sender"`
+	Timestamp        Uint64  `json:"timestamp"`
+	Tips             []Hash  `json:"tips"`
+	TransactionCount Uint64  `json:"transactionCount"`
+}
+
+// DagProductionStats defines model for DagProductionStats.
+type DagProductionStats struct {
+	AverageDifficulty   float64 `json:"averageDifficulty"`
+	DagsCount           Uint64  `json:"dagsCount"`
+	RewardableDagsCount Uint64  `json:"rewardableDagsCount"`
+
+	// Start Timestamp of the week start
+	Start Uint64 `json:"start"`
+
+	// TransactionsCount Number of transactions included to DAG blocks of the validator including duplicates
+	TransactionsCount   Uint64 `json:"transactionsCount"`
+	UnrewardedDagsCount Uint64 `json:"unrewardedDagsCount"`
+}
+
+// DagProductionStatsResponse defines model for DagProductionStatsResponse.
+type DagProductionStatsResponse struct {
+	Data []DagProductionStats `json:"data"`
+}
+
 // DagsPaginatedResponse defines model for DagsPaginatedResponse.
 type DagsPaginatedResponse = PaginatedResponse
 
+// DecentralizationStats defines model for DecentralizationStats.
+type DecentralizationStats struct {
+	Block Uint64              `json:"block"`
+	Dags  DistributionMetrics `json:"dags"`
+	Pbft  DistributionMetrics `json:"pbft"`
+	Stake DistributionMetrics `json:"stake"`
+	Week  uint32              `json:"week"`
+	Year  uint32              `json:"year"`
+}
+
+// Delegation defines model for Delegation.
+type Delegation struct {
+	Amount          BigInt  `json:"amount"`
+	Delegator       Address `json:"delegator"`
+	LastChangeBlock Uint64  `json:"lastChangeBlock"`
+	Validator       Address `json:"validator"`
+}
+
+// DelegationEvent defines model for DelegationEvent.
+type DelegationEvent struct {
+	Amount          BigInt  `json:"amount"`
+	BlockNumber     Uint64  `json:"blockNumber"`
+	Delegator       Address `json:"delegator"`
+	Timestamp       Uint64  `json:"timestamp"`
+	ToValidator     string  `json:"toValidator,omitempty"`
+	TransactionHash Hash    `json:"transactionHash"`
+	Type            string  `json:"type"`
+	Validator       Address `json:"validator"`
+}
+
+// DelegationEventsPaginatedResponse defines model for DelegationEventsPaginatedResponse.
+type DelegationEventsPaginatedResponse struct {
+	Data    []DelegationEvent `json:"data"`
+	End     Uint64            `json:"end"`
+	HasNext bool              `json:"hasNext"`
+	Start   Uint64            `json:"start"`
+}
+
+// DelegationsResponse defines model for DelegationsResponse.
+type DelegationsResponse struct {
+	Data  []Delegation `json:"data"`
+	Total BigInt       `json:"total"`
+}
+
+// DistributionMetrics defines model for DistributionMetrics.
+type DistributionMetrics struct {
+	Gini                float32 `json:"gini"`
+	NakamotoCoefficient Uint64  `json:"nakamotoCoefficient"`
+	Participants        Uint64  `json:"participants"`
+	TopShare            float32 `json:"topShare"`
+	Total               BigInt  `json:"total"`
+}
+
+// EligibilityChange defines model for EligibilityChange.
+type EligibilityChange struct {
+	Block     Uint64 `json:"block"`
+	Eligible  bool   `json:"eligible"`
+	Stake     BigInt `json:"stake"`
+	Timestamp Uint64 `json:"timestamp"`
+}
+
+// EligibilityHistoryResponse defines model for EligibilityHistoryResponse.
+type EligibilityHistoryResponse struct {
+	Data []EligibilityChange `json:"data"`
+}
+
+// EligibleValidator defines model for EligibleValidator.
+type EligibleValidator struct {
+	Address       Address `json:"address"`
+	Distance      BigInt  `json:"distance"`
+	EligibleSince Uint64  `json:"eligibleSince"`
+	Stake         BigInt  `json:"stake"`
+}
+
+// EligibleValidatorsResponse defines model for EligibleValidatorsResponse.
+type EligibleValidatorsResponse struct {
+	Block     Uint64              `json:"block"`
+	Data      []EligibleValidator `json:"data"`
+	Threshold BigInt              `json:"threshold"`
+}
+
 // EventLog defines model for EventLog.
 type EventLog struct {
-	Address          Address  `json:"address"`
-	Data             string   `json:"data"`
+	Address Address `json:"address"`
+	Data    string  `json:"data"`
+
+	// Guess True if the contract has no ABI and the event was guessed by its topic
+	Guess            *bool    `json:"guess,omitempty" rlp:"-"`
 	LogIndex         Uint64   `json:"logIndex"`
 	Name             string   `json:"name"`
 	Params           any      `json:"params"`
@@ -71,17 +295,117 @@ type EventLog struct {
 	TransactionIndex Uint64   `json:"transactionIndex"`
 }
 
+// GasStats defines model for GasStats.
+type GasStats struct {
+	BlocksCount       Uint64 `json:"blocksCount"`
+	EndTimestamp      Uint64 `json:"endTimestamp"`
+	Fast              Uint64 `json:"fast"`
+	FromBlock         Uint64 `json:"fromBlock"`
+	GasUsed           Uint64 `json:"gasUsed"`
+	Max               Uint64 `json:"max"`
+	Median            Uint64 `json:"median"`
+	Min               Uint64 `json:"min"`
+	P10               Uint64 `json:"p10"`
+	P25               Uint64 `json:"p25"`
+	P75               Uint64 `json:"p75"`
+	P90               Uint64 `json:"p90"`
+	SafeLow           Uint64 `json:"safeLow"`
+	Standard          Uint64 `json:"standard"`
+	ToBlock           Uint64 `json:"toBlock"`
+	TransactionsCount Uint64 `json:"transactionsCount"`
+}
+
+// GasStatsHistoryResponse defines model for GasStatsHistoryResponse.
+type GasStatsHistoryResponse struct {
+	Data []GasStats `json:"data"`
+}
+
 // Hash defines model for Hash.
 type Hash = string
 
 // HoldersPaginatedResponse defines model for HoldersPaginatedResponse.
 type HoldersPaginatedResponse = PaginatedResponse
 
+// InclusionStats defines model for InclusionStats.
+type InclusionStats struct {
+	AverageLatency float64 `json:"averageLatency"`
+
+	// Duplicates Number of transaction inclusions into DAG blocks after the first one
+	Duplicates Uint64 `json:"duplicates"`
+	MaxLatency Uint64 `json:"maxLatency"`
+	MinLatency Uint64 `json:"minLatency"`
+
+	// Start Period number or timestamp of the day start
+	Start             Uint64 `json:"start"`
+	TransactionsCount Uint64 `json:"transactionsCount"`
+}
+
+// InclusionStatsResponse defines model for InclusionStatsResponse.
+type InclusionStatsResponse struct {
+	Data []InclusionStats `json:"data"`
+}
+
+// IndexedEventLog defines model for IndexedEventLog.
+type IndexedEventLog struct {
+	Address     Address `json:"address"`
+	BlockNumber Uint64  `json:"blockNumber"`
+	Data        string  `json:"data"`
+
+	// Guess True if the contract has no ABI and the event was guessed by its topic
+	Guess            *bool    `json:"guess,omitempty" rlp:"-"`
+	LogIndex         Uint64   `json:"logIndex"`
+	Name             string   `json:"name"`
+	Params           any      `json:"params"`
+	Removed          bool     `json:"removed"`
+	Timestamp        Uint64   `json:"timestamp"`
+	Topics           []string `json:"topics"`
+	TransactionHash  Hash     `json:"transactionHash"`
+	TransactionIndex Uint64   `json:"transactionIndex"`
+}
+
 // InternalTransactionsResponse defines model for InternalTransactionsResponse.
 type InternalTransactionsResponse struct {
 	Data []Transaction `json:"data"`
 }
 
+// LogsPaginatedResponse defines model for LogsPaginatedResponse.
+type LogsPaginatedResponse struct {
+	Data    []IndexedEventLog `json:"data"`
+	End     Uint64            `json:"end"`
+	HasNext bool              `json:"hasNext"`
+	Start   Uint64            `json:"start"`
+}
+
+// MethodCount defines model for MethodCount.
+type MethodCount struct {
+	Count  Uint64 `json:"count"`
+	Method string `json:"method"`
+}
+
+// NetworkStats Network activity in the window. Only top-level transactions are counted, internal transactions made by contracts aren't included
+type NetworkStats struct {
+	// ActiveSenders Number of unique addresses that sent transactions
+	ActiveSenders Uint64 `json:"activeSenders"`
+	DagsCount     Uint64 `json:"dagsCount"`
+	Fees          BigInt `json:"fees"`
+
+	// NewAddresses Number of addresses that got their first transaction. Internal transactions are included, so contract-created addresses and recipients of internal transfers are counted
+	NewAddresses Uint64 `json:"newAddresses"`
+	PbftCount    Uint64 `json:"pbftCount"`
+
+	// Start Timestamp of the window start
+	Start             Uint64 `json:"start"`
+	TransactionsCount Uint64 `json:"transactionsCount"`
+
+	// Value Value transferred by successful transactions
+	Value BigInt `json:"value"`
+}
+
+// NetworkStatsResponse defines model for NetworkStatsResponse.
+type NetworkStatsResponse struct {
+	Data []NetworkStats `json:"data"`
+}
+
 // OptionalUint64 defines model for OptionalUint64.
 type OptionalUint64 = uint64
 
@@ -118,6 +442,35 @@ type Period struct {
 	StartDate Uint64 `json:"startDate"`
 }
 
+// PeriodRewards defines model for PeriodRewards.
+type PeriodRewards struct {
+	BlockFee BigInt `json:"blockFee"`
+	Period   Uint64 `json:"period"`
+
+	// TotalReward Total minted reward
+	TotalReward BigInt             `json:"totalReward"`
+	Validators  []ValidatorRewards `json:"validators"`
+}
+
+// Signatures defines model for Signatures.
+type Signatures = []string
+
+// StakingHistoryResponse defines model for StakingHistoryResponse.
+type StakingHistoryResponse struct {
+	Data []StakingSnapshot `json:"data"`
+}
+
+// StakingSnapshot defines model for StakingSnapshot.
+type StakingSnapshot struct {
+	Block Uint64 `json:"block"`
+
+	// StakingRatio Share of the total supply that is delegated
+	StakingRatio   float64 `json:"stakingRatio"`
+	Timestamp      Uint64  `json:"timestamp"`
+	TotalDelegated BigInt  `json:"totalDelegated"`
+	TotalSupply    BigInt  `json:"totalSupply"`
+}
+
 // StatsResponse defines model for StatsResponse.
 type StatsResponse struct {
 	DagsCount                Uint64          `json:"dagsCount"`
@@ -129,19 +482,75 @@ type StatsResponse struct {
 	ValidatorRegisteredBlock *OptionalUint64 `json:"validatorRegisteredBlock" rlp:"nil"`
 }
 
+// Supply defines model for Supply.
+type Supply struct {
+	Block               Uint64    `json:"block"`
+	CirculatingSupply   BigInt    `json:"circulatingSupply"`
+	DposContractBalance BigInt    `json:"dposContractBalance"`
+	GenesisSupply       BigInt    `json:"genesisSupply"`
+	LockedAddresses     []Account `json:"lockedAddresses"`
+	LockedSupply        BigInt    `json:"lockedSupply"`
+	StakedSupply        BigInt    `json:"stakedSupply"`
+	TotalSupply         BigInt    `json:"totalSupply"`
+}
+
+// SupplyHistoryResponse defines model for SupplyHistoryResponse.
+type SupplyHistoryResponse struct {
+	Data []SupplySnapshot `json:"data"`
+}
+
+// SupplySnapshot defines model for SupplySnapshot.
+type SupplySnapshot struct {
+	Block       Uint64 `json:"block"`
+	Minted      BigInt `json:"minted"`
+	Timestamp   Uint64 `json:"timestamp"`
+	TotalSupply BigInt `json:"totalSupply"`
+}
+
+// TraceCall defines model for TraceCall.
+type TraceCall struct {
+	Calls   []TraceCall `json:"calls"`
+	From    Address     `json:"from"`
+	Gas     Uint64      `json:"gas"`
+	GasUsed Uint64      `json:"gasUsed"`
+	Input   string      `json:"input"`
+
+	// Method Signature of the called method if it is known
+	Method *string `json:"method,omitempty"`
+	Output string  `json:"output"`
+	To     Address `json:"to"`
+
+	// Type Call type, e.g. call, delegatecall, staticcall or create
+	Type  string `json:"type"`
+	Value BigInt `json:"value"`
+}
+
 // Transaction defines model for Transaction.
 type Transaction struct {
-	BlockNumber Uint64          `json:"blockNumber"`
-	Calldata    *CallData       `json:"calldata,omitempty" rlp:"nil"`
-	From        Address         `json:"from"`
-	GasCost     BigInt          `json:"gasCost"`
-	Hash        Hash            `json:"hash"`
-	Input       string          `json:"input"`
-	Status      bool            `json:"status"`
-	Timestamp   Uint64          `json:"timestamp"`
-	To          Address         `json:"to"`
-	Type        TransactionType `json:"type"`
-	Value       BigInt          `json:"value"`
+	BlockNumber      Uint64    `json:"blockNumber"`
+	Calldata         *CallData `json:"calldata,omitempty" rlp:"nil"`
+	ContractAddress  *Address  `json:"contractAddress,omitempty"`
+	DagInclusions    *Uint64   `json:"dagInclusions,omitempty"`
+	From             Address   `json:"from"`
+	GasCost          BigInt    `json:"gasCost"`
+	GasPrice         Uint64    `json:"gasPrice"`
+	GasUsed          Uint64    `json:"gasUsed"`
+	Hash             Hash      `json:"hash"`
+	InclusionLatency *Uint64   `json:"inclusionLatency,omitempty"`
+	Input            string    `json:"input"`
+
+	// Method Signature of the called contract method decoded on indexing
+	Method *string `json:"method,omitempty"`
+	Nonce  Uint64  `json:"nonce"`
+
+	// RevertReason Reason of the failed transaction decoded from Error(string), Panic(uint256) or custom error of the contract
+	RevertReason     *string         `json:"revertReason,omitempty"`
+	Status           bool            `json:"status"`
+	Timestamp        Uint64          `json:"timestamp"`
+	To               Address         `json:"to"`
+	TransactionIndex Uint64          `json:"transactionIndex"`
+	Type             TransactionType `json:"type"`
+	Value            BigInt          `json:"value"`
 }
 
 // TransactionType defines model for Transaction.Type.
@@ -158,18 +567,176 @@ type TransactionsPaginatedResponse = PaginatedResponse
 // Uint64 defines model for Uint64.
 type Uint64 = uint64
 
+// Undelegation defines model for Undelegation.
+type Undelegation struct {
+	Amount    BigInt  `json:"amount"`
+	Delegator Address `json:"delegator"`
+
+	// Id Id of the undelegation. Zero for undelegations requested before ids were introduced
+	Id               Uint64 `json:"id"`
+	RequestBlock     Uint64 `json:"requestBlock"`
+	RequestTimestamp Uint64 `json:"requestTimestamp"`
+	TransactionHash  Hash   `json:"transactionHash"`
+
+	// UnlockBlock Block starting from which undelegation can be confirmed and funds withdrawn
+	UnlockBlock  Uint64  `json:"unlockBlock"`
+	Validator    Address `json:"validator"`
+	Withdrawable bool    `json:"withdrawable"`
+}
+
+// UndelegationsResponse defines model for UndelegationsResponse.
+type UndelegationsResponse struct {
+	Data  []Undelegation `json:"data"`
+	Total BigInt         `json:"total"`
+}
+
 // Validator defines model for Validator.
 type Validator struct {
-	Address           Address         `json:"address"`
-	PbftCount         Uint64          `json:"pbftCount"`
-	Rank              Uint64          `json:"rank"`
-	RegistrationBlock *OptionalUint64 `json:"registrationBlock" rlp:"nil"`
-	Yield             string          `json:"yield,omitempty" rlp:"-"`
+	Address             Address         `json:"address"`
+	DagsCount           uint64          `json:"dagsCount" rlp:"-"`
+	PbftCount           Uint64          `json:"pbftCount"`
+	Rank                Uint64          `json:"rank"`
+	RegistrationBlock   *OptionalUint64 `json:"registrationBlock" rlp:"nil"`
+	RewardableDagsCount uint64          `json:"rewardableDagsCount" rlp:"-"`
+	Rewards             string          `json:"rewards" rlp:"-"`
+	Stake               string          `json:"stake" rlp:"-"`
+	VoteWeight          uint64          `json:"voteWeight" rlp:"-"`
+	Yield               string          `json:"yield,omitempty" rlp:"-"`
+}
+
+// ValidatorEvent defines model for ValidatorEvent.
+type ValidatorEvent struct {
+	BlockNumber Uint64 `json:"blockNumber"`
+
+	// Changes Names of the profile fields changed by the event
+	Changes         []string `json:"changes"`
+	Commission      *uint64  `json:"commission,omitempty" rlp:"nil"`
+	Description     *string  `json:"description,omitempty" rlp:"nil"`
+	Endpoint        *string  `json:"endpoint,omitempty" rlp:"nil"`
+	Owner           string   `json:"owner,omitempty"`
+	Timestamp       Uint64   `json:"timestamp"`
+	TransactionHash Hash     `json:"transactionHash"`
+	Type            string   `json:"type"`
+	Validator       Address  `json:"validator"`
+}
+
+// ValidatorEventsPaginatedResponse defines model for ValidatorEventsPaginatedResponse.
+type ValidatorEventsPaginatedResponse struct {
+	Data    []ValidatorEvent `json:"data"`
+	End     Uint64           `json:"end"`
+	HasNext bool             `json:"hasNext"`
+	Start   Uint64           `json:"start"`
+}
+
+// ValidatorHistoryResponse defines model for ValidatorHistoryResponse.
+type ValidatorHistoryResponse struct {
+	Data []ValidatorWeekStats `json:"data"`
+}
+
+// ValidatorProfile defines model for ValidatorProfile.
+type ValidatorProfile struct {
+	Address Address `json:"address"`
+
+	// Commission Commission in hundredths of a percent
+	Commission                Uint64  `json:"commission"`
+	Description               string  `json:"description"`
+	Endpoint                  string  `json:"endpoint"`
+	LastCommissionChangeBlock Uint64  `json:"lastCommissionChangeBlock"`
+	LastUpdateBlock           Uint64  `json:"lastUpdateBlock"`
+	Owner                     Address `json:"owner"`
+	RegistrationBlock         Uint64  `json:"registrationBlock"`
+	RegistrationTimestamp     Uint64  `json:"registrationTimestamp"`
+}
+
+// ValidatorRewards defines model for ValidatorRewards.
+type ValidatorRewards struct {
+	// Bonus Block author bonus for including votes
+	Bonus BigInt `json:"bonus"`
+
+	// Dags Reward for the produced DAG blocks
+	Dags BigInt `json:"dags"`
+
+	// Fees Transaction fees that are added to the validator commission pool
+	Fees      BigInt  `json:"fees"`
+	Total     BigInt  `json:"total"`
+	Validator Address `json:"validator"`
+
+	// Votes Reward for the cert votes
+	Votes BigInt `json:"votes"`
+}
+
+// ValidatorRewardsResponse defines model for ValidatorRewardsResponse.
+type ValidatorRewardsResponse struct {
+	FromBlock Uint64 `json:"fromBlock"`
+
+	// PeriodsCount Number of distribution periods the validator got rewards in
+	PeriodsCount Uint64           `json:"periodsCount"`
+	Rewards      ValidatorRewards `json:"rewards"`
+	ToBlock      Uint64           `json:"toBlock"`
+}
+
+// ValidatorSet defines model for ValidatorSet.
+type ValidatorSet struct {
+	Block      Uint64           `json:"block"`
+	TotalStake BigInt           `json:"totalStake"`
+	Validators []ValidatorStake `json:"validators"`
+}
+
+// ValidatorStake defines model for ValidatorStake.
+type ValidatorStake struct {
+	Address Address `json:"address"`
+	Stake   BigInt  `json:"stake"`
+}
+
+// ValidatorStakeChange defines model for ValidatorStakeChange.
+type ValidatorStakeChange struct {
+	Block     Uint64 `json:"block"`
+	Delta     BigInt `json:"delta"`
+	Stake     BigInt `json:"stake"`
+	Timestamp Uint64 `json:"timestamp"`
+}
+
+// ValidatorStakeHistoryResponse defines model for ValidatorStakeHistoryResponse.
+type ValidatorStakeHistoryResponse struct {
+	Data []ValidatorStakeChange `json:"data"`
+}
+
+// ValidatorWeekStats defines model for ValidatorWeekStats.
+type ValidatorWeekStats struct {
+	PbftCount Uint64 `json:"pbftCount"`
+	Rank      Uint64 `json:"rank"`
+
+	// Share Share of PBFT blocks produced by the validator in the week
+	Share          float64 `json:"share" rlp:"-"`
+	TotalPbftCount Uint64  `json:"totalPbftCount"`
+	Week           uint32  `json:"week"`
+	Year           uint32  `json:"year"`
+	Yield          string  `json:"yield,omitempty"`
 }
 
 // ValidatorsPaginatedResponse defines model for ValidatorsPaginatedResponse.
 type ValidatorsPaginatedResponse = PaginatedResponse
 
+// VoteStats defines model for VoteStats.
+type VoteStats struct {
+	// EligiblePeriods Number of periods the validator was eligible to vote in
+	EligiblePeriods   Uint64  `json:"eligiblePeriods"`
+	MissedPeriods     uint64  `json:"missedPeriods" rlp:"-"`
+	ParticipationRate float64 `json:"participationRate" rlp:"-"`
+
+	// Start Timestamp of the window start
+	Start Uint64 `json:"start"`
+
+	// VoteWeight Sum of the validator cert votes weight in the window
+	VoteWeight   Uint64 `json:"voteWeight"`
+	VotedPeriods Uint64 `json:"votedPeriods"`
+}
+
+// VoteStatsResponse defines model for VoteStatsResponse.
+type VoteStatsResponse struct {
+	Data []VoteStats `json:"data"`
+}
+
 // Week defines model for Week.
 type Week struct {
 	Week *int32 `json:"week"`
@@ -198,12 +765,21 @@ type AddressParam = AddressFilter
 // BlockNumParam defines model for blockNumParam.
 type BlockNumParam = Uint64
 
+// ChainStatsWindowParam defines model for chainStatsWindowParam.
+type ChainStatsWindowParam string
+
+// GasBlocksParam defines model for gasBlocksParam.
+type GasBlocksParam = uint64
+
 // HashParam defines model for hashParam.
 type HashParam = Hash
 
 // PaginationParam defines model for paginationParam.
 type PaginationParam = PaginationFilter
 
+// TopParam defines model for topParam.
+type TopParam = uint64
+
 // WeekParam defines model for weekParam.
 type WeekParam = Week
 
@@ -213,6 +789,12 @@ type GetAddressDagsParams struct {
 	Pagination PaginationParam `form:"pagination" json:"pagination"`
 }
 
+// GetAddressDelegationsHistoryParams defines parameters for GetAddressDelegationsHistory.
+type GetAddressDelegationsHistoryParams struct {
+	// Pagination Pagination
+	Pagination PaginationParam `form:"pagination" json:"pagination"`
+}
+
 // GetAddressPbftsParams defines parameters for GetAddressPbfts.
 type GetAddressPbftsParams struct {
 	// Pagination Pagination
@@ -223,6 +805,9 @@ type GetAddressPbftsParams struct {
 type GetAddressTransactionsParams struct {
 	// Pagination Pagination
 	Pagination PaginationParam `form:"pagination" json:"pagination"`
+
+	// Method Signature of the contract method, e.g. delegate(address). Only calls of the method to the selected contract are returned if specified
+	Method *string `form:"method,omitempty" json:"method,omitempty"`
 }
 
 // GetAddressYieldParams defines parameters for GetAddressYield.
@@ -240,12 +825,132 @@ type GetAddressYieldForIntervalParams struct {
 	ToBlock Uint64 `form:"toBlock" json:"toBlock"`
 }
 
+// GetChainStatsParams defines parameters for GetChainStats.
+type GetChainStatsParams struct {
+	// Window Window to calculate stats for
+	Window *GetChainStatsParamsWindow `form:"window,omitempty" json:"window,omitempty"`
+}
+
+// GetChainStatsParamsWindow defines parameters for GetChainStats.
+type GetChainStatsParamsWindow string
+
+// GetChainStatsHistoryParams defines parameters for GetChainStatsHistory.
+type GetChainStatsHistoryParams struct {
+	// Window Window to calculate stats for
+	Window *GetChainStatsHistoryParamsWindow `form:"window,omitempty" json:"window,omitempty"`
+
+	// FromBlock From block number, first block with saved stats by default
+	FromBlock *Uint64 `form:"fromBlock,omitempty" json:"fromBlock,omitempty"`
+
+	// ToBlock To block number, last indexed block by default
+	ToBlock *Uint64 `form:"toBlock,omitempty" json:"toBlock,omitempty"`
+
+	// Points Number of points in the history
+	Points *uint64 `form:"points,omitempty" json:"points,omitempty"`
+}
+
+// GetChainStatsHistoryParamsWindow defines parameters for GetChainStatsHistory.
+type GetChainStatsHistoryParamsWindow string
+
+// GetGasStatsParams defines parameters for GetGasStats.
+type GetGasStatsParams struct {
+	// Blocks Number of blocks to calculate gas price stats for
+	Blocks *GasBlocksParam `form:"blocks,omitempty" json:"blocks,omitempty"`
+}
+
+// GetGasStatsHistoryParams defines parameters for GetGasStatsHistory.
+type GetGasStatsHistoryParams struct {
+	// Blocks Number of blocks to calculate gas price stats for
+	Blocks *GasBlocksParam `form:"blocks,omitempty" json:"blocks,omitempty"`
+
+	// FromBlock From block number, 0 by default
+	FromBlock *Uint64 `form:"fromBlock,omitempty" json:"fromBlock,omitempty"`
+
+	// ToBlock To block number, last indexed block by default
+	ToBlock *Uint64 `form:"toBlock,omitempty" json:"toBlock,omitempty"`
+
+	// Points Number of points in the history
+	Points *uint64 `form:"points,omitempty" json:"points,omitempty"`
+}
+
 // GetHoldersParams defines parameters for GetHolders.
 type GetHoldersParams struct {
 	// Pagination Pagination
 	Pagination PaginationParam `form:"pagination" json:"pagination"`
 }
 
+// GetLogsParams defines parameters for GetLogs.
+type GetLogsParams struct {
+	// Address Address of the contract that emitted the log
+	Address *Address `form:"address,omitempty" json:"address,omitempty"`
+
+	// Topic0 First topic of the log(event signature hash)
+	Topic0 *Hash `form:"topic0,omitempty" json:"topic0,omitempty"`
+
+	// Topic1 Second topic of the log
+	Topic1 *Hash `form:"topic1,omitempty" json:"topic1,omitempty"`
+
+	// FromBlock First block to search logs in. Defaults to 0
+	FromBlock *Uint64 `form:"fromBlock,omitempty" json:"fromBlock,omitempty"`
+
+	// ToBlock Last block to search logs in. Defaults to the latest block
+	ToBlock *Uint64 `form:"toBlock,omitempty" json:"toBlock,omitempty"`
+
+	// Pagination Pagination
+	Pagination PaginationParam `form:"pagination" json:"pagination"`
+}
+
+// GetStakingHistoryParams defines parameters for GetStakingHistory.
+type GetStakingHistoryParams struct {
+	// FromBlock From block number, 0 by default
+	FromBlock *Uint64 `form:"fromBlock,omitempty" json:"fromBlock,omitempty"`
+
+	// ToBlock To block number, last indexed block by default
+	ToBlock *Uint64 `form:"toBlock,omitempty" json:"toBlock,omitempty"`
+}
+
+// GetDailyStatsParams defines parameters for GetDailyStats.
+type GetDailyStatsParams struct {
+	FromTimestamp *Uint64 `form:"fromTimestamp,omitempty" json:"fromTimestamp,omitempty"`
+	ToTimestamp   *Uint64 `form:"toTimestamp,omitempty" json:"toTimestamp,omitempty"`
+}
+
+// GetDecentralizationStatsParams defines parameters for GetDecentralizationStats.
+type GetDecentralizationStatsParams struct {
+	// Week Week to filter by
+	Week *WeekParam `form:"week,omitempty" json:"week,omitempty"`
+
+	// Top Number of the largest participants to calculate share for
+	Top *TopParam `form:"top,omitempty" json:"top,omitempty"`
+}
+
+// GetInclusionStatsParams defines parameters for GetInclusionStats.
+type GetInclusionStatsParams struct {
+	FromBlock *Uint64 `form:"fromBlock,omitempty" json:"fromBlock,omitempty"`
+	ToBlock   *Uint64 `form:"toBlock,omitempty" json:"toBlock,omitempty"`
+}
+
+// GetDailyInclusionStatsParams defines parameters for GetDailyInclusionStats.
+type GetDailyInclusionStatsParams struct {
+	FromTimestamp *Uint64 `form:"fromTimestamp,omitempty" json:"fromTimestamp,omitempty"`
+	ToTimestamp   *Uint64 `form:"toTimestamp,omitempty" json:"toTimestamp,omitempty"`
+}
+
+// GetMonthlyStatsParams defines parameters for GetMonthlyStats.
+type GetMonthlyStatsParams struct {
+	FromTimestamp *Uint64 `form:"fromTimestamp,omitempty" json:"fromTimestamp,omitempty"`
+	ToTimestamp   *Uint64 `form:"toTimestamp,omitempty" json:"toTimestamp,omitempty"`
+}
+
+// GetSupplyHistoryParams defines parameters for GetSupplyHistory.
+type GetSupplyHistoryParams struct {
+	// FromBlock From block number, 0 by default
+	FromBlock *Uint64 `form:"fromBlock,omitempty" json:"fromBlock,omitempty"`
+
+	// ToBlock To block number, last indexed block by default
+	ToBlock *Uint64 `form:"toBlock,omitempty" json:"toBlock,omitempty"`
+}
+
 // GetTotalYieldParams defines parameters for GetTotalYield.
 type GetTotalYieldParams struct {
 	// BlockNumber Block Number
@@ -259,6 +964,30 @@ type GetValidatorsParams struct {
 
 	// Pagination Pagination
 	Pagination PaginationParam `form:"pagination" json:"pagination"`
+
+	// SortBy Metric to rank validators by
+	SortBy *GetValidatorsParamsSortBy `form:"sortBy,omitempty" json:"sortBy,omitempty"`
+
+	// Order Order of the returned list
+	Order *GetValidatorsParamsOrder `form:"order,omitempty" json:"order,omitempty"`
+}
+
+// GetValidatorsParamsSortBy defines parameters for GetValidators.
+type GetValidatorsParamsSortBy string
+
+// GetValidatorsParamsOrder defines parameters for GetValidators.
+type GetValidatorsParamsOrder string
+
+// GetEligibleValidatorsParams defines parameters for GetEligibleValidators.
+type GetEligibleValidatorsParams struct {
+	// BlockNumber Block Number
+	BlockNumber *BlockNumParam `form:"blockNumber,omitempty" json:"blockNumber,omitempty"`
+}
+
+// GetValidatorSetParams defines parameters for GetValidatorSet.
+type GetValidatorSetParams struct {
+	// BlockNumber Block Number
+	BlockNumber *BlockNumParam `form:"blockNumber,omitempty" json:"blockNumber,omitempty"`
 }
 
 // GetValidatorsTotalParams defines parameters for GetValidatorsTotal.
@@ -272,3 +1001,192 @@ type GetValidatorParams struct {
 	// Week Week to filter by
 	Week *WeekParam `form:"week,omitempty" json:"week,omitempty"`
 }
+
+// GetValidatorDagStatsParams defines parameters for GetValidatorDagStats.
+type GetValidatorDagStatsParams struct {
+	FromTimestamp *Uint64 `form:"fromTimestamp,omitempty" json:"fromTimestamp,omitempty"`
+	ToTimestamp   *Uint64 `form:"toTimestamp,omitempty" json:"toTimestamp,omitempty"`
+}
+
+// GetValidatorEligibilityHistoryParams defines parameters for GetValidatorEligibilityHistory.
+type GetValidatorEligibilityHistoryParams struct {
+	FromBlock *Uint64 `form:"fromBlock,omitempty" json:"fromBlock,omitempty"`
+	ToBlock   *Uint64 `form:"toBlock,omitempty" json:"toBlock,omitempty"`
+}
+
+// GetValidatorEventsParams defines parameters for GetValidatorEvents.
+type GetValidatorEventsParams struct {
+	// Pagination Pagination
+	Pagination PaginationParam `form:"pagination" json:"pagination"`
+}
+
+// GetValidatorHistoryParams defines parameters for GetValidatorHistory.
+type GetValidatorHistoryParams struct {
+	// FromWeek Week in YYYYWW format
+	FromWeek *int32 `form:"fromWeek,omitempty" json:"fromWeek,omitempty"`
+
+	// ToWeek Week in YYYYWW format
+	ToWeek *int32 `form:"toWeek,omitempty" json:"toWeek,omitempty"`
+}
+
+// GetValidatorRewardsParams defines parameters for GetValidatorRewards.
+type GetValidatorRewardsParams struct {
+	// FromBlock From block number
+	FromBlock *Uint64 `form:"fromBlock,omitempty" json:"fromBlock,omitempty"`
+
+	// ToBlock To block number, last indexed block by default
+	ToBlock *Uint64 `form:"toBlock,omitempty" json:"toBlock,omitempty"`
+}
+
+// GetValidatorStakeHistoryParams defines parameters for GetValidatorStakeHistory.
+type GetValidatorStakeHistoryParams struct {
+	// FromBlock From block number
+	FromBlock *Uint64 `form:"fromBlock,omitempty" json:"fromBlock,omitempty"`
+
+	// ToBlock To block number, last indexed block by default
+	ToBlock *Uint64 `form:"toBlock,omitempty" json:"toBlock,omitempty"`
+}
+
+// GetValidatorVotesParams defines parameters for GetValidatorVotes.
+type GetValidatorVotesParams struct {
+	Window        *GetValidatorVotesParamsWindow `form:"window,omitempty" json:"window,omitempty"`
+	FromTimestamp *Uint64                        `form:"fromTimestamp,omitempty" json:"fromTimestamp,omitempty"`
+	ToTimestamp   *Uint64                        `form:"toTimestamp,omitempty" json:"toTimestamp,omitempty"`
+}
+
+// GetValidatorVotesParamsWindow defines parameters for GetValidatorVotes.
+type GetValidatorVotesParamsWindow string
+
+// PutContractAbiJSONRequestBody defines body for PutContractAbi for application/json ContentType.
+type PutContractAbiJSONRequestBody = ContractAbiUpload
+
+// PostSignaturesJSONRequestBody defines body for PostSignatures for application/json ContentType.
+type PostSignaturesJSONRequestBody = Signatures
+
+// Getter for additional properties for ContractAbiArtifact. Returns the specified
+// element and whether it was found
+func (a ContractAbiArtifact) Get(fieldName string) (value interface{}, found bool) {
+	if a.AdditionalProperties != nil {
+		value, found = a.AdditionalProperties[fieldName]
+	}
+	return
+}
+
+// Setter for additional properties for ContractAbiArtifact
+func (a *ContractAbiArtifact) Set(fieldName string, value interface{}) {
+	if a.AdditionalProperties == nil {
+		a.AdditionalProperties = make(map[string]interface{})
+	}
+	a.AdditionalProperties[fieldName] = value
+}
+
+// Override default JSON handling for ContractAbiArtifact to handle AdditionalProperties
+func (a *ContractAbiArtifact) UnmarshalJSON(b []byte) error {
+	object := make(map[string]json.RawMessage)
+	err := json.Unmarshal(b, &object)
+	if err != nil {
+		return err
+	}
+
+	if raw, found := object["abi"]; found {
+		err = json.Unmarshal(raw, &a.Abi)
+		if err != nil {
+			return fmt.Errorf("error reading 'abi': %w", err)
+		}
+		delete(object, "abi")
+	}
+
+	if len(object) != 0 {
+		a.AdditionalProperties = make(map[string]interface{})
+		for fieldName, fieldBuf := range object {
+			var fieldVal interface{}
+			err := json.Unmarshal(fieldBuf, &fieldVal)
+			if err != nil {
+				return fmt.Errorf("error unmarshaling field %s: %w", fieldName, err)
+			}
+			a.AdditionalProperties[fieldName] = fieldVal
+		}
+	}
+	return nil
+}
+
+// Override default JSON handling for ContractAbiArtifact to handle AdditionalProperties
+func (a ContractAbiArtifact) MarshalJSON() ([]byte, error) {
+	var err error
+	object := make(map[string]json.RawMessage)
+
+	object["abi"], err = json.Marshal(a.Abi)
+	if err != nil {
+		return nil, fmt.Errorf("error marshaling 'abi': %w", err)
+	}
+
+	for fieldName, field := range a.AdditionalProperties {
+		object[fieldName], err = json.Marshal(field)
+		if err != nil {
+			return nil, fmt.Errorf("error marshaling '%s': %w", fieldName, err)
+		}
+	}
+	return json.Marshal(object)
+}
+
+// AsContractAbi returns the union data inside the ContractAbiUpload as a ContractAbi
+func (t ContractAbiUpload) AsContractAbi() (ContractAbi, error) {
+	var body ContractAbi
+	err := json.Unmarshal(t.union, &body)
+	return body, err
+}
+
+// FromContractAbi overwrites any union data inside the ContractAbiUpload as the provided ContractAbi
+func (t *ContractAbiUpload) FromContractAbi(v ContractAbi) error {
+	b, err := json.Marshal(v)
+	t.union = b
+	return err
+}
+
+// MergeContractAbi performs a merge with any union data inside the ContractAbiUpload, using the provided ContractAbi
+func (t *ContractAbiUpload) MergeContractAbi(v ContractAbi) error {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+
+	merged, err := runtime.JSONMerge(t.union, b)
+	t.union = merged
+	return err
+}
+
+// AsContractAbiArtifact returns the union data inside the ContractAbiUpload as a ContractAbiArtifact
+func (t ContractAbiUpload) AsContractAbiArtifact() (ContractAbiArtifact, error) {
+	var body ContractAbiArtifact
+	err := json.Unmarshal(t.union, &body)
+	return body, err
+}
+
+// FromContractAbiArtifact overwrites any union data inside the ContractAbiUpload as the provided ContractAbiArtifact
+func (t *ContractAbiUpload) FromContractAbiArtifact(v ContractAbiArtifact) error {
+	b, err := json.Marshal(v)
+	t.union = b
+	return err
+}
+
+// MergeContractAbiArtifact performs a merge with any union data inside the ContractAbiUpload, using the provided ContractAbiArtifact
+func (t *ContractAbiUpload) MergeContractAbiArtifact(v ContractAbiArtifact) error {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+
+	merged, err := runtime.JSONMerge(t.union, b)
+	t.union = merged
+	return err
+}
+
+func (t ContractAbiUpload) MarshalJSON() ([]byte, error) {
+	b, err := t.union.MarshalJSON()
+	return b, err
+}
+
+func (t *ContractAbiUpload) UnmarshalJSON(b []byte) error {
+	err := t.union.UnmarshalJSON(b)
+	return err
+}